@@ -72,6 +72,23 @@ func (b *Blocks) handleGetBlock(w http.ResponseWriter, req *http.Request) error
 	})
 }
 
+func (b *Blocks) handleGetBlockHeader(w http.ResponseWriter, req *http.Request) error {
+	revision, err := b.parseRevision(mux.Vars(req)["revision"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "revision"))
+	}
+
+	// the summary contains header only, no txs or receipts loaded
+	summary, err := b.getBlockSummary(revision)
+	if err != nil {
+		if b.repo.IsNotFound(err) {
+			return utils.WriteJSON(w, nil)
+		}
+		return err
+	}
+	return utils.WriteJSON(w, buildJSONBlockHeader(summary.Header))
+}
+
 func (b *Blocks) parseRevision(revision string) (interface{}, error) {
 	if revision == "" || revision == "best" {
 		return nil, nil
@@ -120,5 +137,6 @@ func (b *Blocks) isTrunk(blkID thor.Bytes32, blkNum uint32) (bool, error) {
 func (b *Blocks) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 	sub.Path("/{revision}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetBlock))
+	sub.Path("/{revision}/header").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetBlockHeader))
 
 }
@@ -72,6 +72,36 @@ func TestBlock(t *testing.T) {
 
 }
 
+func TestBlockHeader(t *testing.T) {
+	initBlockServer(t)
+	defer ts.Close()
+
+	_, statusCode := httpGet(t, ts.URL+"/blocks/"+invalidBytes32+"/header")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	res, statusCode := httpGet(t, ts.URL+"/blocks/"+blk.Header().ID().String())
+	assert.Equal(t, http.StatusOK, statusCode)
+	var full JSONBlockHeader
+	if err := json.Unmarshal(res, &full); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, revision := range []string{blk.Header().ID().String(), "1", "best"} {
+		res, statusCode = httpGet(t, ts.URL+"/blocks/"+revision+"/header")
+		assert.Equal(t, http.StatusOK, statusCode)
+		var rh JSONBlockHeader
+		if err := json.Unmarshal(res, &rh); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, full, rh)
+		assert.Equal(t, *buildJSONBlockHeader(blk.Header()), rh)
+	}
+
+	res, statusCode = httpGet(t, ts.URL+"/blocks/100/header")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "null", string(res[:4]))
+}
+
 func initBlockServer(t *testing.T) {
 	db := muxdb.NewMem()
 	stater := state.NewStater(db)
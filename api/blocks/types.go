@@ -8,15 +8,15 @@ package blocks
 import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
 
-type JSONBlockSummary struct {
+type JSONBlockHeader struct {
 	Number       uint32       `json:"number"`
 	ID           thor.Bytes32 `json:"id"`
-	Size         uint32       `json:"size"`
 	ParentID     thor.Bytes32 `json:"parentID"`
 	Timestamp    uint64       `json:"timestamp"`
 	GasLimit     uint64       `json:"gasLimit"`
@@ -28,7 +28,12 @@ type JSONBlockSummary struct {
 	StateRoot    thor.Bytes32 `json:"stateRoot"`
 	ReceiptsRoot thor.Bytes32 `json:"receiptsRoot"`
 	Signer       thor.Address `json:"signer"`
-	IsTrunk      bool         `json:"isTrunk"`
+}
+
+type JSONBlockSummary struct {
+	*JSONBlockHeader
+	Size    uint32 `json:"size"`
+	IsTrunk bool   `json:"isTrunk"`
 }
 
 type JSONCollapsedBlock struct {
@@ -88,11 +93,10 @@ type JSONExpandedBlock struct {
 	Transactions []*JSONEmbeddedTx `json:"transactions"`
 }
 
-func buildJSONBlockSummary(summary *chain.BlockSummary, isTrunk bool) *JSONBlockSummary {
-	header := summary.Header
+func buildJSONBlockHeader(header *block.Header) *JSONBlockHeader {
 	signer, _ := header.Signer()
 
-	return &JSONBlockSummary{
+	return &JSONBlockHeader{
 		Number:       header.Number(),
 		ID:           header.ID(),
 		ParentID:     header.ParentID(),
@@ -102,12 +106,18 @@ func buildJSONBlockSummary(summary *chain.BlockSummary, isTrunk bool) *JSONBlock
 		GasUsed:      header.GasUsed(),
 		Beneficiary:  header.Beneficiary(),
 		Signer:       signer,
-		Size:         uint32(summary.Size),
 		StateRoot:    header.StateRoot(),
 		ReceiptsRoot: header.ReceiptsRoot(),
 		TxsRoot:      header.TxsRoot(),
 		TxsFeatures:  uint32(header.TxsFeatures()),
-		IsTrunk:      isTrunk,
+	}
+}
+
+func buildJSONBlockSummary(summary *chain.BlockSummary, isTrunk bool) *JSONBlockSummary {
+	return &JSONBlockSummary{
+		JSONBlockHeader: buildJSONBlockHeader(summary.Header),
+		Size:            uint32(summary.Size),
+		IsTrunk:         isTrunk,
 	}
 }
 
@@ -236,7 +236,7 @@ func swaggerUiSwaggerUiJs() (*asset, error) {
 	return a, nil
 }
 
-var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x93\xdb\xb8\xb1\xe8\xf7\xf9\x15\x28\xe7\xd6\x95\x37\x65\x6b\x40\xf0\x05\xce\xb7\xdd\xb5\x73\x32\x95\x3d\xb1\xaf\xd7\x37\x39\x55\xa7\x4e\x5d\xe1\xd1\x90\x18\x53\xa4\x42\x42\x33\x9a\xbb\x39\xff\xfd\x14\x00\x92\x22\x25\x8a\x92\x66\x34\x9b\xf1\xc6\xda\xaa\xad\x31\x89\x47\xa3\xbb\xd1\x2f\x34\x9a\xc5\x0a\x72\xb6\x4a\x6f\x90\x3f\xc5\x53\xef\x2a\xcd\x55\x71\x73\x85\x90\x4e\x75\x06\x37\xe8\xf3\xa2\x28\xa1\xd2\x57\x08\x49\xa8\x44\x99\xae\x74\x5a\xe4\x37\xe8\x1f\x57\x08\x21\xf4\xe9\xfd\xcf\x9f\xd5\x3a\x43\xdf\x7f\xbc\x45\xba\x40\x4c\x08\xa8\x2a\xf4\x17\xf8\x71\xc1\xd2\xdc\x76\x45\x7f\x06\x7d\x5f\x94\x5f\xae\x6c\xfb\xff\xfc\x58\x16\x7f\x03\xa1\xd1\x1f\x8b\x25\xfc\xd7\xeb\x85\xd6\xab\xea\xe6\xfa\x7a\x9e\xea\xc5\x9a\x4f\x45\xb1\xbc\xbe\x03\x61\xfa\x5e\xeb\x45\x51\x7e\x77\x85\x50\x96\x0a\xc8\x2b\xb8\xb1\xdd\x73\xb6\x84\x1b\xf4\xd3\xbf\x7d\xfc\xc9\xc0\x6a\x1f\xad\xcb\xec\x06\x4d\x9a\x81\xee\xef\xef\xa7\xf3\x7c\x3d\x2d\xca\xf9\x75\xdd\xb3\xba\xce\xe6\xab\xec\xad\x59\x1b\xe4\xd3\x85\x5e\x66\x93\x2b\x84\xee\xa0\xac\xec\x3a\xbc\xa9\x3f\x25\x57\x57\x15\x94\xe6\x91\x99\xe6\x6d\x3d\xe6\xf5\xc4\x4e\xd0\x5b\x75\x56\x08\x96\x21\x03\x1b\xca\x0b\x09\x57\x57\x9a\xcd\xeb\x4e\x0e\xb6\xef\x85\x28\xd6\xb9\xae\xf6\xbb\x7e\xef\x70\xe3\xb0\x64\xda\xa0\x82\x1b\x54\x54\x9d\xde\x9f\x4b\x96\x57\x4c\x98\x0e\xa3\x23\xe8\x7e\xbb\xa6\xfb\x0f\x59\x21\xbe\x8c\x76\xe4\x4d\x8b\xa6\xcb\x4f\xc5\x7c\xb4\x03\xdc\x41\xae\xd1\xff\x76\x33\x2a\x28\x51\xe6\x3a\x34\xfd\xff\x6c\xb0\x30\xd2\xdf\x60\x09\x55\x9a\xe9\x75\x85\x0c\x63\x75\xba\xfe\xbc\xe6\x6d\x97\x01\x18\xea\xd7\x1c\x50\x9a\x6b\x30\x2c\x08\x12\x55\xeb\x3d\x9c\xbd\x03\xbe\x9e\xef\x77\xb7\x8f\xd1\x5a\xa7\x59\xaa\x53\x70\xe3\x5f\xad\x98\x5e\x58\x72\x5d\xd7\x34\xa8\xae\x7f\x61\x52\x96\x50\x55\xff\xed\x38\x6c\xc5\x4a\xb6\x04\x5d\xb3\x82\xf9\xbd\x45\xff\xab\x04\x75\x83\x26\xbf\xbb\x16\xc5\x72\x55\xe4\x60\xba\x6d\xdb\x5d\x7f\xef\x06\xb8\xcd\x3f\x32\xbd\x98\x9c\xda\xeb\x13\xdc\xa5\x86\x03\x6f\xf3\xff\xb3\x86\xf2\xc1\xf5\x9b\x83\x6e\xa6\x6d\x18\xab\x19\xae\xc7\x58\x08\x55\xeb\xe5\x92\x95\x0f\x37\xe8\x13\xe8\x32\x85\x3b\x68\xb9\x4a\x82\x66\x69\x56\x37\x1b\xd8\xb2\xe6\x97\xe6\x22\x5b\x4b\xa8\xd0\x8c\xb3\x8c\xe5\x02\x66\x6f\xd0\x0c\x72\x28\xe7\x0f\x33\xc4\x72\x89\x66\x0b\x56\xfd\x58\x48\xf3\x9c\x3f\xb4\x43\xcf\x6a\x5c\xcd\xa6\xe8\xfb\xbc\x7d\x7a\x9f\xea\xc5\xb6\x03\xe2\x80\x7e\xaf\xcb\x35\xfc\x1e\xa5\x15\x62\x48\x14\xb9\x2e\x99\xd0\xd3\xab\x76\xf6\x3f\xa6\x95\x2e\xca\xd4\xec\xa4\x3e\xd0\x48\xb0\xdc\xf4\xff\xfb\x1a\xca\x14\xa4\x99\xba\x5a\x81\x48\xd5\x43\x9a\xcf\xd1\xac\xac\x51\x36\xb3\x0d\x1e\x50\xa5\xcb\x34\x9f\x4f\xeb\x71\x4b\xa8\x56\x85\xd9\xef\x5b\xac\x4d\x08\xc6\x93\xed\x3f\x77\xd0\xf1\xe1\x4f\x9d\x37\x06\x4c\xc8\x75\xb7\x31\x42\x6c\xb5\xca\x52\xc1\x4c\xf3\xeb\xbf\x55\x45\xde\x7f\x8b\x50\x25\x16\xb0\x64\xbb\x4f\xd1\x20\xe9\x5d\xdb\xea\xba\xa6\xe3\xc4\xa1\x63\x55\x54\xed\x9c\x12\x56\x25\x08\xa6\x41\xde\x20\x83\xc0\x33\x19\xe1\xfd\x06\xc4\x5a\x6f\xf9\x40\x34\x3b\xf3\x20\x17\xe8\x02\x55\xe9\x72\x9d\x31\x0d\x2d\x99\xd0\x12\xf4\xa2\x90\x48\xb0\x2c\x7b\x63\x49\x5b\xac\x35\xaa\x20\x97\x86\x04\x1d\xb9\xd3\x4a\x13\x64\xe5\xf5\xb4\x1d\xb5\xfd\xe3\x56\x4f\x2a\xb4\xae\xc0\xe8\x07\x23\x49\x2a\x9d\x2e\xcd\x54\x73\x66\x1e\xb3\x39\x58\x4e\x03\x0b\xb6\x19\xb0\x84\x6a\x9d\x69\x54\x28\xc3\x35\x19\x5b\x57\xb0\x25\xed\xdf\xd7\x50\xe9\x1f\x0a\xf9\xb0\xc5\x44\x6f\x51\xac\x9c\xaf\x97\x06\xcf\x6e\xcc\xfc\x2e\x2d\x8b\xdc\x3c\x68\x9b\x9b\x31\xd2\x72\x07\xb7\x83\x74\x1f\xa7\xfa\x30\xcd\xc7\x28\xfe\x23\xcb\xb2\x77\x4c\xb3\xc9\xd7\xc5\xa8\x06\xec\x4f\x96\x24\x93\x9e\xc0\xfc\xfd\xcd\x1e\xe7\xee\x0b\xcd\xc7\x0a\xc0\x47\xb0\x3b\xe2\x4c\x8b\x85\x61\x1b\xc3\xf1\xd5\xe9\x2c\xbf\xe5\x3c\xcb\x72\x1d\xde\xfe\x6d\xf0\xdd\x0f\x06\x2f\x5f\x29\xf3\xb5\xb0\x37\x1c\xd8\x65\xc1\x7d\x06\x3c\x20\x3a\xff\x99\x7c\xc9\x1f\x34\x9c\xc9\x90\xad\x0c\x96\xb0\xca\x8a\x07\xc3\x46\xbf\x86\x04\x1e\x9a\xf6\xb0\x2c\xee\x0c\xff\xbb\xdf\xfd\x0e\x7d\xbe\xfd\xf8\x73\x97\xb4\x6f\xd1\x4c\x32\xcd\x66\x28\xcd\x9b\xed\x83\x78\x21\x1f\x8c\x31\xa0\x17\x1d\xb4\xd4\x63\xd7\x73\x1f\x1c\xc1\x71\x6b\x6f\x88\x72\x9d\xeb\x74\xd9\x1d\x8a\x55\x55\x3a\xcf\x41\x76\x8d\xeb\xfb\x45\x2a\x16\xb6\x7d\xbb\x3e\x83\x2f\xa8\x57\x09\xf2\x37\xb1\xc7\xbf\xd2\xed\x7d\x68\x67\x6f\xad\xf1\x6b\x43\xd9\xdf\x8a\x49\x7e\xdc\x14\x4b\x15\x62\xf9\xc3\x14\xfd\x11\x4a\xa8\x99\x56\x82\x61\xf8\x3d\x66\xff\xca\xcc\x5d\xe3\x13\x1c\xa4\xb1\x71\x03\xd8\x1c\xae\x7f\xf9\x02\x0f\xbf\xb6\xff\xf5\xb3\x9b\xfb\x4f\xf0\xf0\x52\xb8\xa4\xc6\x06\xba\x63\xd9\xfa\x08\xbb\xa8\xa2\x44\xf3\xf4\x0e\x72\xf4\x05\x1e\xbe\x32\x8e\xa8\x11\xef\x98\xa2\x1b\xc8\xb8\xfe\x25\x95\x8f\xe7\x82\xcf\x9b\xdb\x77\xe7\x52\x92\xdd\xef\x28\xf9\xa3\x5d\xfe\x08\x4c\x9e\xdb\xe7\xa3\x53\xdd\xa7\xf2\xcb\x5e\x0c\x68\x88\x67\x3a\x78\x1b\xe7\x14\xfe\x80\x6e\xdf\x4d\xd1\x5f\x17\x90\xa3\xd9\xca\x41\x32\xb3\x9a\xb4\x5c\xc3\x1b\xc4\xd0\xaa\x31\x2c\x36\xce\x91\xcf\xd7\x59\x86\x66\x4b\x30\x1a\x78\x99\xce\x17\xda\xe8\xcc\x12\xf4\xba\xcc\x41\xbe\x40\x56\x2b\x72\xf8\xa0\xf6\x1f\x1b\x4c\xb2\x2c\x1b\x7e\x75\x88\x68\x0d\x8b\x7e\xde\x4c\x06\x7b\xad\xca\x62\x05\xa5\x4e\xbb\xeb\xee\xff\x0c\xde\x0e\xbd\xeb\xda\x09\x8a\x65\x15\x1c\x6c\x37\x0e\xdb\xbf\xc3\x56\xdf\x5f\x68\xc1\x9f\xd8\xfd\xd7\xb9\xe6\x1d\x36\x2b\xd9\xfd\xc0\xd6\xd8\xfe\x60\xc3\x96\xab\x0c\x86\xa0\x4d\xe5\x0d\x9a\xe0\x4d\x20\x21\xf6\x14\x91\x21\xa5\x8c\x51\xe6\x01\xc3\x58\x01\xf5\x3d\x22\x13\x92\x44\x91\x64\x01\x09\x64\x92\xf8\x09\x0b\x3d\x4f\x09\xcc\x81\x7a\x10\x85\x8a\xc9\x90\x30\x45\x87\x80\xb4\xe6\xf9\x67\x36\xbf\x41\xde\xc0\x5b\x6b\xc2\x7f\xb2\x8b\xc7\x1b\xec\x7e\x5e\x33\xf6\xd0\x70\xb0\x59\xa5\x25\x73\x0b\xf6\xf1\xd0\x7c\xd6\x60\xaf\x6e\xd0\x7f\xfe\xd7\xc0\xdb\x39\xab\x3e\x96\xa9\x80\x1f\x0b\x33\xa7\x47\xe8\x70\x9b\x1b\x44\x3c\x8c\x87\x86\x2f\xca\x74\x9e\xe6\x16\xdc\x38\x8c\x62\x49\x7d\x1e\x73\x2a\x29\x66\x52\x0a\x4e\xa8\xc7\x62\x4f\x86\x81\x12\x31\xf7\xfd\x28\x50\x0a\xe4\xd0\x32\x24\x64\x30\x67\xba\x28\x6f\xac\xcc\x19\x68\x91\x17\xb9\x00\x3b\xcf\x2e\xee\x87\xc7\x33\xa2\xac\xfa\x90\x1f\x1c\xaf\x4a\xff\x3f\xdc\x20\x8f\x0e\x2d\xea\x30\x13\x5b\xfa\xdc\xbe\xeb\x91\x47\x04\x21\x4d\x82\x24\xa1\x21\x8b\x24\x8d\x78\xec\xf9\x49\x94\x60\x4e\xa9\xe7\x49\xe9\xf3\x20\x0a\x62\x81\x89\x0c\x54\xe0\x09\x09\x8a\xc7\xd2\x27\x3e\x89\x87\x59\xd8\xce\xf0\xe7\xf5\x92\x43\x39\xcc\x22\x75\x93\xcf\xe9\x12\x2a\xcd\x96\xab\x1b\xe4\x85\xc4\xf7\xc2\x88\xc4\xde\xb0\x1a\xbd\x2e\x41\x40\xba\xd2\xbf\xa6\x3a\xdd\xd3\x8d\x17\x54\x72\xa8\x5e\xcf\x29\xca\xee\xe5\xe9\xa8\x83\x72\xf9\x88\x54\x76\x6b\xde\x67\x9a\x8e\x4c\xee\x3e\x3e\x8b\xad\x4f\x98\xd8\x09\xdd\x5d\xfe\xda\x8f\xbe\x9c\x43\xdc\x1f\x8b\xe5\x32\xd5\xa7\xdb\x2f\x69\x6e\x84\xfa\x68\x40\xee\x9f\xe7\x7d\xf7\xd4\xe6\x0b\xe2\xb7\x51\x5d\xfa\x1f\xb7\xef\x1c\x51\xdd\x59\xe0\xf5\x2f\xcd\xb1\xca\xe3\x6d\xef\xad\x4b\x74\x96\xc0\x78\xbf\x59\xb1\x5c\xc2\xc9\x42\xa3\x73\xbc\x39\x24\x2e\xec\x7a\x4e\x10\x10\xa8\x28\x51\x6e\xa5\xed\x1b\xf3\xe7\x84\x43\xa5\x27\xd6\xa5\xca\x98\xb6\xf1\x2a\x33\xd0\x14\xdd\x2a\x34\x83\x1a\xc4\xe6\xc8\xa9\xb0\x43\x76\xec\xe7\x2c\xeb\x1d\xc6\x22\x96\x15\xf9\xdc\x5a\xd2\xed\xa4\x7a\x01\x69\xd9\x08\xb0\x0a\xdd\xa7\x59\x66\x6c\x6a\x58\x72\x90\x12\x24\x5a\xe7\x12\x4a\x34\xeb\x0e\x33\x43\x2a\x85\x4c\xa2\x34\xaf\x34\x30\x89\x0a\x85\x52\xf9\x12\x5d\xff\xe7\xb0\xbe\x2d\x99\x87\x35\xe5\x78\xc7\xdb\xea\x73\xb9\xce\x0f\x74\x3d\x6e\xc7\xee\x0b\xb9\xa1\x5f\x0f\xb1\x5d\x0d\x75\xfb\xae\x3a\xd4\x67\x50\x34\xd7\x93\x3e\xac\xe0\x06\xb1\xb2\x64\x0f\x07\xdb\xa4\x1a\x96\x23\x10\x35\x83\xb8\xe3\xd0\x91\x66\x8d\xf5\x6b\x2c\x19\x42\x03\xce\x59\x88\x41\xc5\x71\x4c\x69\xa2\x94\xc7\xfc\x28\x06\x89\xb9\x4f\x65\x08\x61\x44\xa2\xd8\x0b\x82\x38\x16\x01\x96\xe0\x53\x19\x7b\x02\xa4\x8c\x54\xa2\x58\x10\x0f\x5a\x32\xff\x1a\x34\x6f\xf7\xed\x81\x7d\xbf\xb3\xdf\x9f\x97\xf2\x23\x08\x6f\x7e\x8f\x73\x75\x4f\xeb\x7d\xd0\x42\x41\x7b\x58\xab\x05\x69\x2d\xa5\x77\x3a\x1c\xf4\xcb\xf2\xda\x2a\xf6\x49\xe8\x93\x60\xef\x75\xed\xb4\x61\x8c\x03\x15\x09\x41\x29\xe7\x41\x44\x22\x96\x90\x04\xc7\xb1\x47\x81\x12\x45\xc2\x90\x53\x65\xbc\xb5\x20\xf4\x59\x4c\x81\xc6\x49\x0c\x9c\x0a\x60\xbe\x9f\xf8\x9c\x78\xe1\x3e\xfc\xce\x55\xf0\x63\x7f\xdf\xf6\x62\x25\xe4\x7a\xeb\x0f\x98\x89\x79\xec\x63\xc9\x65\x82\x15\x48\x9c\x48\x2f\x0a\xb9\x92\xca\xf7\x85\xc0\x00\x32\x88\x41\xe0\x88\x26\x3e\x55\x11\x40\xcc\x63\xe1\x11\x16\x00\x4b\xe8\x80\x5f\xa4\xbb\x36\xbe\xef\x93\x28\x4e\x06\x9c\xb0\x39\xab\x7e\x4a\x97\xa9\xbe\x41\x9e\x47\x42\x3f\x8c\x93\xbd\x26\x1c\x72\x50\xa9\x48\xad\x8e\x9c\xe0\x0d\x0f\x70\x12\x08\x12\x2a\x1a\xc9\x88\x50\x25\x65\x18\x7b\x4c\x89\x00\xc7\xb1\xc2\x12\x7b\x49\xc4\x14\x0f\x06\x1c\xd8\x39\xab\xfe\x6f\x65\xcc\xab\x61\x87\x50\x17\x9a\x65\x3f\x8b\xa2\x34\xbe\x15\x26\x49\x42\xf7\x3d\x4a\xbd\xa9\x3e\x15\x85\xb6\x80\xd0\x44\x2a\x99\x28\x21\x3d\x2c\x12\x08\x7d\x19\xd1\x30\x21\x42\x51\x1e\x06\x98\x13\x8a\x79\x4c\xa4\x4f\x3d\x4e\x23\x1a\x12\x9f\x10\x3f\x49\x88\xf2\x01\x27\x8c\xe2\x88\xf3\x01\x9c\x6d\xaa\x3f\x00\xd3\xeb\xd2\xd8\xc3\xfb\x00\x56\x9a\x69\xd8\x4e\x1f\x71\x21\x22\x49\xbc\x80\x8b\x44\x52\x89\x25\x48\xce\x3c\xec\x11\x16\xf9\x82\xfa\x5e\x2c\xbd\x44\x40\x12\xab\x08\x0b\xca\x08\xa8\x50\x84\x09\xe7\x32\xc0\x32\x20\x91\xb7\x3f\x7d\xb3\xd3\xdb\x29\xbc\x30\xa6\x31\x90\xd0\xf7\x45\x10\x63\xa0\x2c\xa2\x14\x22\x21\xbd\x98\x79\x00\x1e\x91\x34\x08\x8d\xd4\x95\xa1\xa2\x44\x12\xe1\xe1\x04\x88\x8c\x08\x89\x24\x85\x30\x18\x70\xfa\xed\xf1\x56\x69\x07\x67\x3c\xe6\x24\x56\x22\x81\x58\x92\x44\x25\x8a\x40\xc8\xa5\x1f\x79\x71\x10\xb3\x30\xf4\x42\x89\x85\x20\x72\x00\xce\xd4\x89\xca\x1d\x33\xb9\x45\xe2\x11\x49\xf8\xf6\x32\x5a\xc3\x18\x9e\x59\x31\xaf\xae\x6d\x62\xd9\x71\x5f\xa2\xcd\x4f\xeb\x58\x7c\x7f\x48\x33\x0d\x65\x9d\x9a\x96\x6d\x1b\x1c\x30\xfa\xde\xb7\xed\x10\x2b\xc1\x28\x05\xb9\x16\x2e\xbb\x68\xf6\xe1\xe3\xff\xfb\xe9\xc3\xbf\xd9\xb3\xc6\xf7\x7f\xf9\xf7\x17\xea\x66\xd8\x05\xb8\x45\xbf\x40\x67\x63\x4c\x8f\x1d\xd4\x5f\x8f\x36\x14\x2c\x2e\x86\xf4\xcd\x31\x5d\x3f\x16\xa5\x1c\x9b\xf0\xa7\x62\xbe\xf5\x83\x2d\xe7\x36\xa9\x90\x4f\x62\xde\xdd\x7c\x4a\x74\x98\x7f\x3f\x77\x9b\x5a\x16\x2e\x41\x14\xa5\x51\xa6\x45\x8e\xfe\xf2\xfe\x73\x3b\x58\x3f\x1d\xee\x45\xf1\x70\xb3\x88\x6f\x6c\xdc\x43\xc7\x3f\x8d\x93\xf3\x42\xc2\x75\xee\x52\xb3\xaf\x57\xd0\x7a\xfb\x23\xee\xf7\x9f\xb7\xa7\xd8\xfb\xce\xb7\x28\xf2\x1c\x84\x06\x89\xec\x60\x2f\x8f\xbe\x07\x69\x38\x86\xb2\x8f\x00\xe5\xcf\x9a\xe9\xca\x21\xad\xea\x66\x2c\xbb\xf8\xc9\x51\xac\xed\x67\x39\x77\xd0\xf7\xfa\xaf\xc0\xab\x42\x7c\x01\xfd\x5d\x27\xdf\x39\x87\xfb\x6d\xa2\x36\x7a\x6c\x1e\xd3\xc7\xa2\x4a\xf5\x7e\x1e\xd3\xcb\xa3\xcc\x23\xa3\xa3\x07\x5d\xc6\xf1\x6e\x1f\x78\x55\x64\xa0\x07\x8c\xac\xf1\x7d\x77\xdc\x53\x1c\x8b\x0c\x0c\x76\x38\xe6\x01\x8e\x7a\x7f\x27\xf8\xfc\x97\xf5\xf7\xf7\x37\x40\xc7\x84\xbb\xfc\x06\xb0\x83\x1f\xd1\x8c\x2e\xc7\xab\x62\x3a\xad\xd4\x03\x12\x65\xaa\xa1\x4c\x99\x31\xe6\x6c\xb4\x6e\x2f\x65\xed\x92\xfb\x68\x7b\x01\x81\x49\x59\x76\x28\x90\xe6\x37\x6e\xfa\xce\xb3\x21\xd6\x3f\x40\xc0\x7e\xea\x99\x4b\x6d\x41\x85\xaa\xad\x5d\x58\xa6\x5a\x43\xb9\x07\x83\xc6\xcf\x04\x81\x2e\x56\xa9\xc0\x2d\x00\xfb\x13\x7b\xcf\x39\xb1\x37\x32\x31\x79\xce\x89\xc9\xc8\xc4\xfe\x73\x4e\xec\x8f\x4c\x1c\x3c\xe7\xc4\xc1\xee\xc4\x5f\xbf\x86\x38\xe8\x2b\x3c\x8f\x86\x78\xdc\x59\x5b\x6b\x95\xed\x76\xba\xea\xfd\xb9\x23\x7a\xfb\x3e\xc8\xe5\xa5\x6f\x33\xfe\x65\x04\xf0\xf3\xc8\x5d\xbd\xf9\x60\x33\x11\x9e\x69\x57\xb8\x98\x4b\x57\x04\xeb\x4d\xbd\x60\xc3\xdc\x2c\xcd\x5d\x3a\x72\x83\xaa\x3d\xf8\x2a\xc8\x25\xfc\x0a\x9a\x41\x17\x5f\x20\xdf\x9d\xad\x01\xa2\x04\x91\xae\xd2\xae\x38\x79\x66\x38\x76\x27\xfc\x1a\xc4\xc8\x53\xbc\xb5\x17\x2a\x4d\x06\xdc\x15\x60\xcf\x62\xac\x75\xae\x20\x4c\x2a\x64\x66\x39\x49\x68\xd4\x7b\xa8\x19\xdd\x30\xd0\xd6\xef\x79\x63\x53\xed\x79\x56\x14\x4b\xa4\x6c\xc4\xc0\xec\x35\xa6\x91\x5d\x72\x95\x1a\x3f\xd3\x9e\xb5\x30\xa5\x9c\xd7\x59\xf3\xe1\x36\x3f\xfa\x92\x32\xe7\xb7\xc0\xc3\x3f\x00\x3b\x70\x4e\x73\x2a\xff\x1a\x96\x92\xc0\xd7\x73\xa3\x7d\x44\x8b\xd8\xb1\x00\xd8\xf6\x6e\x6e\x37\x05\xa4\x04\x66\x2f\x6e\xb9\x61\x06\x98\xa5\x1d\x41\x15\x65\x7b\x01\xa5\xa5\xc5\x4b\x8b\x6b\x09\x28\x3f\x58\xb8\x27\xae\xf9\xd5\xcb\xe3\x1a\x27\xc9\xdd\x7d\xf3\x0e\x1d\xeb\x8c\xf3\xb7\x25\xcb\xe7\xf0\x48\x6a\xb6\x11\xa0\x26\x7d\xdd\x0e\x36\x2e\x01\x8c\xb2\x58\x40\xff\x02\xb1\x4b\x67\xaf\xb7\xf1\xcb\xa4\x75\x9d\xb9\xfe\xc9\x2c\xb0\xa6\xf8\xcb\x23\xf5\xa9\x0b\x98\x5c\x5d\x6d\x5b\x98\x61\xea\x46\x6e\xc4\xfa\xd2\x42\x33\xfc\x90\xaa\xaa\x6f\x8e\x77\x21\x38\xc5\x60\xa8\xbb\x19\x1b\x71\x9d\xa7\x1a\xfd\xf5\xfd\xed\x1b\xb4\x2a\xa1\x82\xbc\x95\xea\x0b\xd8\xec\x8f\xd2\x8d\x66\x04\xb1\x52\x9e\x4a\xb0\x4f\x62\xc6\xb0\xa2\x1d\xed\xea\x6e\xb1\x9f\x0b\x95\xeb\x65\x81\x4a\xf3\x47\x02\x25\x54\x44\x02\x2f\xa4\x32\x4c\x3c\x3f\xe9\x1c\xa4\xd6\x57\xe3\xf7\x61\xe2\x45\x91\x01\xcb\x0f\x01\x75\xbf\x00\xbd\x80\xb2\xb7\x57\x16\xac\xea\x5e\x27\xea\xc1\xe0\x12\xac\xed\x9b\xee\x7c\x43\xc4\x13\x83\xf0\x8c\x2e\x2f\xc2\xe6\xbf\x00\x87\x24\xc2\x18\x53\xac\x24\xc6\xcc\x8b\xc2\x88\xc4\x2c\x66\x31\xf1\x71\x48\x09\x16\xc4\x97\x3e\x03\x22\x05\x8d\x98\xf4\x7c\x1c\x46\x1e\x23\x94\x24\x92\xc6\x22\x16\x9c\x06\x7e\xe8\x47\x61\x90\x10\x2e\xbd\x30\xa0\xc0\x63\x88\x95\xc0\xca\x8f\x7c\xc2\x21\xc1\x98\x24\xf5\xdd\xf8\x9a\x5b\xc7\x96\x61\xaf\xca\x9c\xb9\x0e\xfc\xb4\x9f\x57\x43\xe7\x72\xd2\x6f\x86\x04\x5d\x37\xf6\x67\xcc\xb8\xa6\xf0\xc5\xa1\x55\x34\x19\xc6\xe7\xee\x24\x6b\x78\xa5\x12\x72\x9d\xaa\x14\x4a\xf4\x9a\x3f\x68\xa8\x7c\xf2\xdd\xe1\x95\x5f\x28\x4d\xa2\x9b\xb1\xbc\x07\x75\x9a\x6b\x98\xf7\x1c\x1f\x55\x94\x4b\xa6\x6f\xec\xde\xf2\xc9\xf8\x7a\x5c\xc6\x07\x7a\xbd\x80\x74\xbe\xd0\x83\x4b\xd9\x49\x06\xd9\xc9\x8d\x3e\x13\x9e\x28\x18\x87\x67\x9d\xa7\x9b\x6d\x56\xc6\x10\x38\x9d\x3c\x0d\xfb\xba\x93\x3d\x3b\xcc\x1e\x9b\x26\x65\xe0\x1b\x77\xfc\x4b\x71\x47\x3b\xf1\xe6\x7c\x72\x76\x65\xca\x96\xa8\x07\x48\x79\xb9\xe4\xbe\x26\xc6\xf2\x14\x70\xdd\x7d\x11\xf4\xda\x05\x54\x0e\xb1\x9f\xe4\x01\x26\x71\x10\xc7\x9c\x30\xaa\x20\x10\xd4\x17\x91\x64\x0a\x62\x45\xa3\x28\xa6\x9c\x7b\x9c\x32\x2a\x6b\xf1\x5b\x3b\xba\x83\x1b\xcc\x85\xca\x8b\xfe\x21\xfb\xb7\xbd\xb6\xcb\xdb\xdf\xf6\xda\x09\xcc\xfb\x2f\xbe\xd7\x9a\xde\xce\x05\xbf\xcd\x25\x6c\x2e\xc7\x66\xa9\x19\xce\x16\x6e\xb1\xa3\xd7\x81\xa1\xb9\xb1\xc5\x99\x86\x0a\xe9\x45\x5a\x99\xad\x3b\xb4\x8a\x5a\xd7\xfe\xb0\x3d\x83\x1f\xde\xd1\xf9\x0b\xd9\x1a\xa9\x3c\x81\xac\x0d\x08\xb5\xf4\x38\x55\xdc\x3c\xbb\x90\xb1\xd9\xb0\x17\x43\xe1\xa7\x9f\x3e\x22\xc8\x8d\x07\x52\x67\x03\xdb\xf1\x8d\xef\x65\xd7\x3d\x88\xcc\x4e\x22\x6e\x9b\x80\x7b\x31\x7c\xba\x11\x6b\x58\x6e\xdf\x8d\xa3\xf3\x02\xb9\xbe\xfa\x45\x49\xc8\x36\x97\xf8\xc2\xc0\xcc\x59\x85\x32\x33\x30\x7a\xbd\x64\x1b\xc4\xb2\xac\xb8\x07\x69\x1c\xd9\xb5\xad\x77\x93\xde\x75\x0b\xd1\x14\xaa\x97\x61\x31\xb8\xa5\xf6\x72\x9d\xbb\x39\xce\x17\xe3\x86\xce\x89\x46\xe3\x74\xeb\xc2\x59\xec\xcd\x25\x23\x54\xc2\x3d\x2b\xe5\x01\x46\x39\x3f\xd3\xba\xc9\xb0\xbe\x18\x05\x4e\x43\xf2\x10\xfc\xfd\x1c\xef\x4e\x6e\xf7\xc5\x60\xab\xd6\x4b\x8b\xdb\x2c\x43\x2c\x17\x50\xe9\x92\x65\x75\xd8\x7f\x82\x2a\x33\xd7\x20\xed\x77\x32\xcb\x9b\x8c\xf2\x8b\x91\xbd\x2c\x0a\x1b\x5d\x59\xec\x62\xc9\x08\x26\x5b\xb9\xc8\x52\xfe\x00\xcd\x2f\x97\xd4\xde\x4d\x66\xbf\x98\xc8\xad\xd6\xab\x55\x51\x6a\x90\x66\x78\xa4\xea\xf1\x11\x4f\x75\x05\x7a\x58\xbd\x36\x8f\xb6\xd9\xf3\xcf\x83\xea\xa6\x2c\x8c\x9b\xe8\x10\x7a\x2f\x96\xb4\xdf\x4b\xd6\x7f\x76\xe6\x19\xba\x05\xd4\x5d\xd7\xe5\x6e\x0a\xd4\x37\x04\xce\x5c\x11\xc1\x07\x8d\xca\x05\xa0\x22\x37\x36\x59\x81\x9a\xe2\x5a\xc6\x1c\xeb\x5e\xb2\xdc\x5d\xcd\xe9\x57\x13\xec\x00\x3f\x5a\xab\x6f\xcc\x78\xd3\xc5\xb9\xb6\xf0\xa4\x3d\x7e\xde\xda\x95\x6f\x5c\x4d\x13\x55\x94\x83\xa5\xce\x5a\x5f\x6d\x72\x60\x59\x21\xf6\x03\xc6\xc2\x04\x7b\x24\xe4\x51\x80\x89\xcf\x30\x89\x88\xe7\x11\x9e\x50\x19\x13\xf0\x05\x85\x00\x77\x88\x71\x6a\x58\xb2\x07\xfa\x02\x36\x96\x38\xdb\xa3\x74\x57\xaf\xac\x4d\xee\x86\x43\x0a\x27\x88\x95\xe4\xbe\xf0\x55\x10\x46\x02\x63\x8c\xb7\x90\x48\xd6\x3f\x45\x3e\x05\x90\x34\x5f\xad\xb5\xed\x59\xe3\xe6\x90\x1b\xd1\x46\x42\xc7\x68\x78\x92\xe1\xdb\x9f\x7f\xeb\x47\xd7\x07\x45\xc3\x25\x3d\x9e\xc7\x0b\x2b\x1e\xe7\x83\x0d\x6d\x97\x53\x00\x3f\xdf\x15\xdb\xd6\xcd\x78\x04\x8c\x6d\x67\x0b\xe9\x8a\xa5\x0e\x4e\x63\x22\x28\x18\x94\xbe\xbd\x5a\x1a\x97\x75\x04\x0c\x73\x39\xdb\x7f\x9f\xce\xee\xb8\x3f\xad\xba\xde\xc2\xa0\x5d\xd0\xa9\x80\xd2\xd6\x59\x39\x13\x42\x7a\x08\xc0\x8c\x55\xda\x41\x59\x28\xeb\x97\x56\x8d\x04\x3c\xe0\x26\xf8\x49\x3f\x16\xf2\x09\xd4\xb9\x54\xa2\x4e\x32\xa3\x55\x09\x2a\xb5\xde\x71\x55\x2c\xe1\x5c\xe7\xa4\x73\x1e\xb6\xad\x16\x73\x31\xc2\x4d\xb6\x83\xa2\x12\x6a\x33\xb3\xa9\x46\xf9\x09\xd4\x9b\xf6\x74\x8f\xef\x26\x69\xb7\x40\xc7\x1d\xdd\xd3\x14\xac\xd9\x03\x70\x37\x37\x7a\x20\x23\x7a\xb4\xf8\x9c\x1d\xb7\x67\x67\x6f\x4b\xdf\x5c\x8a\x49\x44\x01\xca\x38\x21\x46\x97\xac\x2b\x57\x84\x52\xb0\x4c\xb8\x9a\x9e\x86\xad\x55\x9a\xb3\xcc\xee\xb1\x95\x99\x7d\xdc\xde\x9a\xb3\xb3\xed\xbe\xc3\xb6\xb6\x75\xbc\x96\xae\xd8\xbc\xb2\x10\xd4\x15\xb6\x45\x91\x57\xeb\xa5\x03\x16\xea\x8a\xa5\x56\xbf\x1f\x91\x58\x7d\xf7\x60\x5b\x72\xe7\x62\x96\xd4\xed\xbb\x21\x61\x50\xe4\xdd\x7a\x9e\xeb\xd2\xfa\xeb\xdd\x06\x35\x24\xa8\xc8\xa7\xcd\x12\x8d\xe0\x9a\x1e\x95\x68\xae\xc6\xd0\x71\xf0\x7b\xca\x26\x11\x24\x8c\xc1\x8f\x80\x45\x10\x13\x36\xb9\xaa\x0f\x7e\xd8\xfd\xb8\x2e\x2c\xd9\xfd\x53\xac\x82\x26\x68\x72\x5c\xab\x28\x1a\x25\xd4\xe3\x8c\x62\xcc\x24\x93\x49\x12\x9c\x72\xb4\x19\x07\x91\xa2\x84\xc4\x1e\xa6\x18\x7b\x94\x84\x04\x53\xf3\x97\xc0\x9c\x06\x5e\x10\x27\x44\x24\x81\x9f\x84\x49\x80\x13\xea\x13\x3f\xc1\x18\xa2\x20\xc6\x71\x40\x84\xa4\x71\x0c\x22\x51\x49\x82\x23\x2e\x18\x0e\x43\x0f\x43\x40\x3c\xe5\x73\xec\xf9\x20\x09\xf1\x7c\x12\x40\x1c\x0b\xe6\x61\xe9\x07\x51\xc4\x7d\xc2\x3d\x8a\xb1\x88\x09\x78\x24\xf6\x12\x4e\x3c\x5f\x79\x32\x10\x7e\x8c\x7d\x1c\xfa\x49\x22\x25\x89\x99\x4a\x22\x12\x91\x28\x30\x66\x8d\x5d\xee\xfb\x3b\x18\xcf\x4c\xa8\x3d\xf8\xc7\xe8\xc7\x8e\xf3\xdf\xda\x8a\x8e\xf3\xea\x6b\xa3\x2e\xe7\xd3\x9d\x30\xbc\xae\x6d\xe8\x43\xf6\xd1\xf9\xd5\xb1\x6c\x26\xf6\xe3\xe4\xe0\xc1\xfb\x20\x3d\x4b\xf1\x62\xd5\xcd\x4e\x34\x2c\x2f\x3b\xb9\x33\x37\x7b\xa9\xcf\xc3\x1c\xe0\x92\x61\xcf\x65\x80\x86\xf8\xd6\xf4\xa8\xac\x3c\xb1\x86\xf8\x21\xef\xed\x7c\xdb\xad\xf5\x4e\x9e\x04\x5a\x1d\x8b\x3a\x02\xdd\xf9\x6e\x8b\xd3\x14\x67\x83\xd6\xea\x97\x51\x70\x06\x9c\x14\x27\x34\xbb\x85\xca\x86\xa9\x79\x89\xf0\xd8\x01\x0d\x66\x2c\x02\xf6\xf0\x78\x56\xe9\x04\x09\x5b\x83\xda\x1a\x01\x73\x76\x39\xae\x31\xa3\x3e\x45\x6f\x6c\x29\x64\xe1\x73\xb9\x4e\x87\x22\x12\xc4\x8f\x40\x09\x2e\x38\xf7\x83\xbe\x2f\xe9\x82\x9e\x97\x01\x64\x34\x80\x1a\xc6\x11\x78\x34\x51\xc6\xa4\xdd\x05\xe1\x0e\x4a\x3d\xc4\x0a\x47\x52\xa9\x74\xb9\x06\xb4\x04\xd6\xcd\xd9\xaf\x4d\x87\x7b\x56\xb5\xe3\x0e\x01\xd4\x2f\x5b\x59\xac\xf5\x6a\xad\x1f\x27\xa2\x0f\x27\x7c\x37\xba\xe6\xfb\x7d\xcd\x35\x8a\xe9\x81\x95\xfe\x63\xef\x35\x6a\x4b\x9e\x6f\x75\x5a\xcd\xbf\x6f\x50\xaa\xea\x72\xd3\xa5\xcb\x61\xb4\xb5\x60\xeb\xf3\xb8\xb4\x42\x6c\x60\xb4\xa1\x20\x4a\x2f\x45\x77\x00\x89\x03\x15\x19\xdd\x05\xbf\x43\x4b\x3d\xf3\x22\xf6\xd9\xd7\x80\xda\x0b\x2e\xbf\x02\x00\xdb\xeb\x03\xb6\x63\x53\xb3\xfd\x12\x89\x6d\x63\x92\x78\x24\x7e\xf4\xc4\xb0\x50\x2f\x94\x26\x58\x87\xb4\x97\xf7\x5e\xea\x63\x23\x1b\xa1\x28\xca\xed\x47\x3b\xf6\x9c\xba\xb3\xb1\xc5\xab\x22\x5b\xd7\x5f\x43\xe8\x3b\x66\x66\x49\xe7\xeb\x04\xd7\xab\x55\x0d\xaf\x97\xd5\x7c\xea\x0c\x91\xc6\x40\xdc\x2b\x73\xe4\xc8\x6c\xb5\x02\x60\x1e\x71\x9f\xc5\x51\x30\x10\xc1\xb3\x52\x31\x8a\xc2\xc0\x8f\x68\xe4\x45\x49\x04\x04\x87\x41\x44\x23\x15\x93\x0e\x57\xb9\x92\xfa\x63\x7c\xf5\x18\xc2\xdb\xd8\x96\x15\x7b\xb6\xfb\x21\xc5\x81\xfd\x30\x8c\x58\xec\x0b\x0f\x83\x4f\x95\x02\xa2\x84\x31\x40\xb0\x12\x89\x0c\x22\x26\xb1\x17\x50\x85\x63\x20\x51\xe0\xc5\xe0\x79\x31\x97\x1e\x08\x48\x64\x12\x50\xde\x39\x6d\xde\x17\x0c\x17\x09\x06\xec\x88\x81\x41\x01\x70\x91\x89\xf6\x6f\x0b\x5d\xfc\x7c\xcf\x1d\xe9\x81\x44\x72\x6d\x28\x37\xb0\x2b\x0e\x5a\x3c\xe7\xa8\xd0\x03\x3a\xf0\x6e\xf9\xbe\x2c\x4f\x8a\x3f\x6e\x19\xa4\xe6\xd2\xde\x37\x69\x46\x13\x94\x7f\xbd\x90\xd0\x37\x81\x75\x50\x60\x59\xda\xdc\x81\xfc\x6b\x51\x7e\x39\x5b\x6c\x6c\xea\xce\xe8\xbe\x28\xbf\xbc\x76\xb8\xd0\x90\x57\xc6\xe8\x6a\xb4\xc7\x77\x4f\xb6\xc4\x2d\x32\x4c\xc7\xa3\x33\x3c\x47\x24\x54\x6f\x3a\xc3\x1e\x85\xe0\xb1\x31\xe1\x26\xe9\x40\x41\x09\xb9\x80\x23\xf3\xec\x69\x99\x81\xbd\xf4\x16\xe9\xe2\x91\x5e\x22\x3a\x4d\x6f\xa1\x93\x74\x17\xea\x6d\x44\x14\xe2\x5d\xe7\xcc\x6e\x14\x34\xf1\x76\x42\x55\x93\x5d\xd6\x7f\x5c\xbc\xa5\xc3\xdd\x6e\x8e\xc9\x3e\x3f\xda\x55\xfa\x0c\x62\x4a\x08\xe1\xc0\x24\xc7\x3e\x25\xd8\xe7\x40\x3c\x90\xa1\x80\x58\x24\xdc\xe3\x4a\x45\x98\x0c\x86\xdd\x51\x4f\xfe\x0e\x95\x7b\xc7\x34\xf4\x04\x53\xbe\xd8\xf6\xef\x4b\xcb\xbe\x62\xdf\x17\x84\x3b\x42\xf0\xe4\x4f\xef\xd8\x1e\xae\x24\x93\xbb\xd0\x54\x8d\xc9\xe4\x42\xa9\x0a\x4e\xca\x13\x1a\x88\x6b\x8f\xba\x29\x6e\x64\x94\xe6\x68\x69\x96\x0c\xb2\x2e\x71\x85\xba\xe9\x09\xd9\xa9\x59\x4a\x9d\xa4\x91\xd3\xa6\x77\x69\x4a\xd6\x2d\x35\xb3\xda\x0f\x97\x3a\x8b\xe7\x6a\xb4\xef\x8a\xd9\x90\x0c\x54\xd0\xb9\x6e\x6a\x5c\xaa\x87\x62\x8d\x72\x00\x59\x5f\x5e\xb5\xeb\x31\x28\x37\xa2\x6a\x0e\x72\x8a\x60\x3a\x9f\x6e\x79\x7f\x36\x9b\xb5\x7f\xff\xd2\x81\xec\x95\x2b\x37\x5c\xbd\xba\xe9\x3d\x36\x2f\x2c\xc2\x5e\xdd\x20\xfc\xa6\xff\xc2\x2e\xe5\x95\x59\x7a\xbf\x84\xc0\x7f\x5f\xed\xff\xd5\x9d\xd6\x06\x3f\x79\x71\x07\x4e\xcc\xd4\x91\xa6\x95\xcb\x1a\x72\xc4\xa9\x10\x76\xf7\x72\x4d\x5b\xfb\xc6\xe5\xed\x55\xc8\xc3\xd3\x3e\x4e\x6a\xb8\xd1\xcc\xf8\x7d\xb3\x06\x23\xb2\xc8\x27\xda\xe1\x45\x17\x48\xc2\xd2\x0c\xb6\x62\x73\x5b\xb5\xac\xc3\x8a\x9f\xb6\xd7\x11\x87\x19\x71\x9d\x0f\x31\xc2\xbe\xf5\x91\xaf\x97\x7d\xcb\xe0\xed\x5e\xfe\x82\x15\x85\xe9\x12\xae\x86\xf8\x67\xb7\xf1\x08\x0b\x49\x50\x69\x5e\x47\x87\xed\xc9\x57\xa1\xd0\x4c\x95\xc5\xb2\xfe\x9e\xaa\x2e\x66\xd3\x5e\x87\x99\x1d\x7c\x56\x07\x25\xba\x69\xa5\x6f\xd0\xcc\x40\xd4\x7f\xd5\x66\xf5\xbd\x31\x53\xb1\x75\x66\xb3\xd2\xea\x41\xfa\x23\xb7\xff\x30\xd3\x5f\x26\x68\xd6\xdd\x47\x5b\x96\x19\xc8\xce\x78\xcc\xe0\x4e\xb6\x5f\x8d\x6f\xb5\x2e\x7e\xed\x0d\x53\xb3\x7c\xb7\xbb\x50\x9a\xbb\x0d\x75\x7c\x3f\xd9\x9e\xfb\xbb\xc9\x10\xec\xd5\x0d\x7a\x65\xb1\xf9\x6a\x67\x47\x19\x2c\xda\x0d\xb5\xf3\x5c\x17\xaf\x76\x44\xfb\xf1\x5d\xd6\xec\xad\xa2\xb3\x0e\x33\x7e\x4d\x64\x0f\x6f\xbf\xe9\x67\x46\xee\xac\xc8\x6d\xa4\x4a\xb3\x5c\x3a\xbb\xd2\x1e\x2c\xae\xb3\xcc\x8d\x32\xc0\x01\xd6\xdf\xf9\xb1\x2e\xc9\xf1\x0c\xc7\x25\x47\x6b\x13\xb9\xd2\x41\x47\x87\x75\x85\x7e\x4e\x6b\x46\x4e\x6b\xe6\x9f\xd6\x2c\x38\xd2\xec\x00\x2b\xb6\x65\x4e\xb6\x1c\x58\xac\xb5\x43\xc2\x14\x7d\x9f\x65\xae\x78\xbb\x2b\xd5\xf8\xb7\x22\xcd\x9b\x1b\xa4\x33\x96\xcb\x19\x32\x04\x60\xba\x28\xa7\x0d\x51\x5d\xa9\x77\xd3\x38\x9d\xe7\x45\x79\x86\x7a\xa8\x49\x60\x58\x77\xfc\x5e\x63\x10\x46\xef\xa3\x30\x26\x51\x1c\x27\x3d\xfe\x7e\xe5\x88\xe4\x46\x90\x52\x91\x90\x30\xe9\x71\x20\x82\x26\x3c\x4a\x04\xe1\x38\xa2\x4a\xf8\x31\x95\x8c\x25\x21\xe1\x2c\x56\x5e\xe4\x8b\x80\x79\x5e\x44\xa8\x0a\x43\x16\x48\x15\x12\x9f\xfb\xa0\x5e\x1d\xe1\x7e\xa7\xdb\xab\xda\xc1\xaf\xf9\xc5\xd5\x61\xc5\x1b\x08\x13\x19\xc4\x21\xe3\x10\x25\xa1\x88\x55\x14\x33\xca\x88\x4f\x3c\xe5\xfb\x8c\x86\x11\xc7\x3c\x10\xb1\x27\x9d\x3c\x75\xf8\x74\xc0\xcf\x10\xfc\x7d\xcd\xb2\xca\x8c\xf2\xd4\x25\xb4\xa2\x74\xcf\x88\x6e\x76\xc9\x59\xa8\xde\xdd\x0b\xd6\x96\x7c\x22\x88\x93\xdd\x9d\x33\x76\xa9\xf5\x71\xe6\xfd\x56\x7e\x38\x85\x3c\x7e\xa6\xdd\x51\xd6\xe8\x88\xf1\xd9\xd1\xef\x9d\x8c\xab\xbe\xe5\x79\xda\x18\xb5\xb9\x3a\xd9\xdb\x95\x3f\x0f\x59\xa8\x97\x08\x1d\x35\xa2\xb4\x9b\x2a\xb6\x73\xc8\x38\x66\xe1\x9a\xb6\x46\x56\xd6\xe5\x4c\x5a\x35\x6e\xb5\xc1\x8c\x55\x62\xf6\x38\x83\x86\x55\x62\xe7\x89\x81\x62\x9f\x9c\x4d\x54\xea\x14\x8d\x70\xc6\x4d\xa4\x6e\x08\xf0\xd4\x2d\xdc\xc9\x5a\x3d\xf5\x9c\xf6\x69\xd3\x9c\x73\xec\xfa\xb8\x03\xfc\x1e\x8a\xbf\x6d\x9a\x6e\x18\xf4\xeb\xdb\x37\xf6\x7f\x6d\xe9\xd7\x31\x3a\xda\x1a\x5b\xe7\xf0\x94\x5e\x14\xe5\xf5\x9d\x37\xc5\x53\xfc\x36\x8a\x28\xe6\x09\x7d\x2b\xe1\xee\x3a\x4b\xf3\xf5\xe6\x7a\x5e\x78\x53\x0f\x4f\xfd\xce\xf5\x4e\xa8\xf4\x0f\x27\x5f\x4a\xdd\x2d\x73\x40\x63\xee\xb3\x40\x06\x42\x2a\x4f\x88\x90\xc8\x30\xe2\x49\x8c\x03\x15\x08\x8f\x2a\x4c\x30\x78\x3c\xa0\x92\x73\x15\x30\xe2\x4b\x0f\x20\x50\x9e\x62\xa1\x52\x49\xd0\x55\x33\xe7\x5c\x02\x69\x61\x88\x68\x90\xc4\x5b\xb7\x10\xa0\x3c\x73\x0d\x21\x06\x8f\x10\x16\xe2\x10\x20\x0c\x39\x0d\x7c\xdf\xc3\x11\x65\x42\x49\x1a\xc6\xe0\xc7\x4c\x86\x54\x05\x91\xcf\xb0\x62\x3c\x61\x4c\x29\x22\x3c\x08\x38\x01\x22\x09\x61\x10\x7b\x52\x78\x81\x92\x4c\x45\x00\x4c\xc6\x01\x97\xbe\x8a\x70\x98\x04\x51\x10\x30\xe6\x87\x22\xa4\x54\x25\x82\x45\x1c\x7c\x3f\xf0\x80\x08\xf0\xa8\x94\x22\xf0\x7c\x9f\x74\x2e\x0d\xe4\x60\x4f\x66\xcf\x82\xde\x23\x74\xea\x4d\xfd\x64\xea\x11\x7c\xe3\x79\xc4\xef\x9c\x70\xa4\x39\x2f\xd6\xf9\x53\x42\xf0\x72\x7d\x7a\x24\x73\x7b\x10\x40\x6b\x39\xf5\x1f\x5b\x4a\x3c\x2a\x65\x7b\xcf\x38\xba\xe8\xc7\x13\xb7\xff\x6b\x6a\x4f\x8d\x06\xa5\x76\xda\x8c\x21\x73\x44\xce\xa4\xb9\x4c\x85\xbd\x75\xda\xad\xba\x52\xd7\x36\x73\xa5\xca\xec\x57\x52\x17\x69\xe5\xce\x40\x39\x08\x9b\x97\x5b\xb2\x5c\x2c\xba\x5f\x35\xe9\x56\x84\xba\x84\xec\x18\x90\x5d\x01\x7f\xd0\xb0\xf3\x8c\xa7\xf3\x92\x2d\x77\x1e\xf6\xce\x66\xdd\x23\xb8\x5b\xca\xb4\xda\x79\x98\x17\xc5\x6a\xe7\x51\xb1\xb2\x69\x2d\x3b\x4f\x57\x25\xec\xde\xd3\xb1\x11\x94\x72\x68\xf6\x75\xbe\xfb\x74\x84\x00\x06\x1d\xf5\xed\x19\x01\xe5\x14\xbd\x5f\xae\xf4\x83\x7b\xda\xf1\x7a\x9b\xd8\x47\xa5\xcb\xb5\xb0\x1f\x75\x98\xbb\x62\xfa\xa6\xcf\x10\xcf\xbf\xea\xd8\xe0\xac\x9c\x0f\xe9\xb9\x71\x37\xb7\x0f\x65\x1d\xde\x51\x29\x48\xb4\x62\xda\xdd\xf7\xb1\xe3\x6e\x4f\xdb\xc5\xf6\x3b\xb9\xee\xf7\xa3\x4b\x58\xcd\x1e\xde\xa0\x22\xcf\x1e\x3a\xe9\x15\xed\xbd\xac\x29\xfa\x83\x8b\x93\xf4\x3a\xce\xea\x7a\x04\xd7\xaf\xf5\xc6\xde\xbd\xfe\x87\xde\xdc\xca\xef\xae\x3b\xb7\xb1\x67\x87\xc5\xbf\x64\x9c\x07\x32\x52\x98\x19\xdb\x25\x66\x32\x16\x12\x03\x8e\x99\xa7\x08\xe6\x61\x10\x49\x8e\x63\x1f\x4b\x1a\x25\x32\x14\x82\x63\x29\x09\xf3\x22\x88\xc3\x24\xe4\xd7\xf8\x1a\xf7\xeb\xf0\x74\xca\x5e\x3d\x43\x34\xa1\x8f\xe6\xfd\xf4\xaa\x03\xcb\x64\x41\x44\x62\xec\x47\x40\x70\x12\x02\x8f\x3d\x41\xfc\xc0\xc3\x61\x20\x19\x8b\xfc\x30\x8e\x05\x8e\x48\xd0\x2d\xc6\xf4\x05\x1e\x7e\xd6\xac\x3c\xd7\x14\x3c\x9e\x3d\x3b\xfe\x9b\x74\xbc\xe0\x4d\x3f\x9c\xbf\x85\xc0\xc5\xff\x86\x20\xe8\x44\xb2\x4f\x66\xe3\x1d\xf0\x41\x82\xe2\x41\x40\x23\x1a\xaa\x44\xc4\x44\x09\xc2\x93\x20\x4a\x28\x06\x15\x7a\x92\x4a\x82\x29\xe7\x8c\x05\xd2\x57\x52\x28\x2c\xc2\x58\x06\x34\x88\x99\x60\x04\x0e\xb0\xc3\xa8\x7c\x83\x8d\xfe\x13\x9c\x72\x31\x77\xf0\xeb\x4f\xfd\x7b\x27\xfd\x52\x50\xdb\xb1\xea\x92\x76\x47\xc6\x9a\xe0\x8d\xef\x43\x40\xfc\x84\x62\x91\x70\x3f\x96\x38\xa0\x5c\x1a\xbd\xc3\x65\xc0\x08\x03\x9e\x84\x5e\x10\x25\x84\xe0\x20\x0c\x70\xc8\x84\x10\x44\x05\x11\x95\x18\x54\x12\x25\x94\x4e\x76\x93\x7e\xbe\xf4\x97\xd6\x4e\xf4\x44\x3e\xd9\xfb\x6e\xcf\x5e\x26\xd0\x85\x66\x12\xf5\x9e\xf8\x01\xd8\x68\xc6\xc8\xb7\x02\x0a\x17\x28\xa0\xf0\xad\x66\xc1\x41\x2a\x3c\xb2\xaa\xcb\xcb\xba\x24\x6d\x2b\xd5\x9e\x41\xdc\x05\x6c\x4e\xb7\x37\xba\x65\x70\x4f\x28\x80\xfb\x4c\x0a\xec\xdb\xef\xeb\xfe\x75\x2c\xa0\xcb\x6d\x99\x7d\x66\xad\x05\x7b\xa1\xdc\x75\x78\xb5\xce\xeb\x2a\x0a\xc6\x7a\xef\x72\xf2\xa0\xc8\xdf\x3e\x73\xb6\x46\xfd\x75\xcf\x51\x67\xb5\xdf\x04\x9d\xea\xfe\xed\xbb\x79\x69\x85\xec\xbd\x53\xa4\xcd\x80\x06\x80\xdd\x1a\xd2\x75\x7a\xb6\xfb\xcc\x71\x33\xe3\xf6\x23\x20\xdb\xc2\xb5\xa9\x95\xd0\xed\x37\x80\x8f\x5c\x1d\xa8\x5b\x0d\x16\xb6\xdd\x2d\xf3\x3a\x28\x58\x86\xef\xf4\x3f\xee\x32\x88\xed\xfc\x89\xdd\xd7\x25\xb0\xfb\xab\x2c\xd9\x7d\x67\x85\xdd\x1a\xf2\x83\xb8\x2d\x9b\xda\xc0\xcc\xf4\xec\x66\xdd\x4f\xf7\xd6\xdc\x8d\x6f\x0c\x2f\xba\x21\xa8\x83\xb0\xfd\xe4\xf4\x10\x98\xf5\xcb\x53\x60\xad\x6f\x0b\xf6\xcc\x92\xa2\x44\xb7\xef\xa6\x36\xf8\xb6\xe5\x0d\x56\xb9\x1b\x93\xa9\x42\x85\x3b\xb9\x9a\x9e\x42\xa3\x1d\x68\xf7\x39\x67\x00\xd8\x43\xac\xf3\x8f\xae\xc2\xb1\x7a\x7f\xf8\x5b\xd6\x1d\x87\xb9\xfb\x49\xeb\x27\xf2\xd9\x36\x2d\x02\x2a\xed\xd6\xd5\xf9\xe4\x7f\x7f\x51\x0b\x60\xf2\x14\xec\xbb\xeb\x9e\xa6\x75\xf3\xd5\xed\x63\x48\x3f\x19\xe7\xb5\x9f\xf2\x27\x78\xe8\x63\x7d\x0c\xc1\x46\x18\x7c\x81\x87\xd7\xab\xba\x0e\xfc\x77\xc6\xab\x67\x42\x98\xfd\xda\xdc\xf3\xa9\x7d\x91\x31\x64\x3a\x1c\x7c\x81\x87\x47\x20\xf7\x72\x15\x6a\x5d\xcc\xbf\x95\x59\x03\x54\xda\x17\x5a\x07\x09\x35\x78\xe1\x29\x15\x0b\xfb\x29\xf4\xe6\x46\x64\xb5\x93\x03\x70\xce\xee\x7e\x14\x36\x82\x30\x82\xe6\xb0\xb5\xb7\xea\x0f\xa5\x84\x72\x70\xcd\xf6\x40\xe1\x94\x15\x77\xca\x95\x9f\x7c\x06\xf1\xe8\x05\xef\xc7\xf9\x76\x4f\x28\x7a\xe7\x7a\x2d\x7e\x4c\x1b\xfb\xec\xf3\xe6\xf6\xdd\xe9\x7c\x5e\xdf\xb2\xde\xbb\x47\x3c\xc2\xcd\xe9\x49\x3b\x6f\x9f\x7c\x09\x17\x22\x0a\x49\xc4\xe2\x88\x41\x18\x61\x12\x04\xca\x38\xd4\x38\x14\x02\x63\x2f\x89\x63\x12\x44\x82\x27\x44\x10\x1e\x28\x0f\x08\x8f\x19\xc1\x01\x04\xc6\x11\x4f\x80\xf5\xbe\x5b\xb7\xf3\x7d\x86\x3e\x65\x57\xc5\x49\x9c\xbc\xa5\x2b\x43\x15\xbb\x6b\x8b\xd0\xdd\xbe\xb3\x02\xb3\x84\x6a\xbd\x74\xa1\x5e\x40\xdd\x2f\x68\xf4\x44\xd3\xed\xbb\xa7\xaa\x84\xf7\xf5\x07\xb1\x07\x97\xd2\x7c\x2d\xfb\xc0\x7a\x86\xd9\xec\xe0\xe7\x36\xb6\x86\x4e\x09\x7a\x5d\xe6\xed\x92\xd3\xaa\x9d\x69\x1c\xf0\x9e\xea\xfd\x08\xf6\x96\xd8\x30\x0d\xdc\xbb\x8b\xc2\x5d\xd4\x60\x23\xbd\x79\x63\xe5\x0c\x4a\xf5\xa4\xda\x99\x6a\x1c\xee\xff\x09\x00\x00\xff\xff\xbb\xbb\x78\x21\xe2\xa0\x00\x00")
+var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x93\xdb\x46\x92\xe0\x77\xfe\x8a\x0a\xcd\xc5\x51\x9e\x90\xd8\x85\xc2\x9b\xdf\x6c\x49\xb3\xee\x18\xef\x48\x27\xe9\xc6\x1b\xb1\xb1\x71\xac\x47\x16\x89\x11\x09\xd0\x40\xb1\x9b\x7d\xf6\xfe\xf7\x8d\x2c\x3c\x49\x82\x68\xb2\x9b\xed\x69\x79\x44\x3a\x66\x5a\x60\x3d\xb2\xf2\x55\x99\x59\x59\x89\x6c\x0d\x29\x5f\x27\x53\xe2\x4e\xe8\xc4\x19\x25\xa9\xce\xa6\x23\x42\x4c\x62\x96\x30\x25\x9f\x17\x59\x0e\x85\x19\x11\xa2\xa0\x90\x79\xb2\x36\x49\x96\x4e\xc9\x6f\x23\x42\x08\xf9\xf8\xee\xd3\x67\xbd\x59\x92\xef\x3f\x5c\x13\x93\x11\x2e\x25\x14\x05\xf9\x3b\xbc\x59\xf0\x24\xb5\x5d\xc9\xdf\xc0\xdc\x66\xf9\x97\x91\x6d\xff\x9f\x1f\xf2\xec\x1f\x20\x0d\xf9\x31\x5b\xc1\x7f\xbd\x5c\x18\xb3\x2e\xa6\x57\x57\xf3\xc4\x2c\x36\x62\x22\xb3\xd5\xd5\x0d\x48\xec\x7b\x65\x16\x59\xfe\xdd\x88\x90\x65\x22\x21\x2d\x00\x01\x22\x24\xe5\x2b\x98\x92\x9f\xfe\xed\xc3\x4f\x08\xab\x7d\xb4\xc9\x97\x53\x32\xae\x07\xba\xbd\xbd\x9d\xcc\xd3\xcd\x24\xcb\xe7\x57\x55\xcf\xe2\x6a\x39\x5f\x2f\x5f\xe3\xda\x20\x9d\x2c\xcc\x6a\x39\x1e\x11\x72\x03\x79\x61\xd7\xe1\x4c\xdc\x09\x1b\x8d\x0a\xc8\xf1\x11\x4e\xf3\xba\x1a\xf3\x0a\xdb\xed\xad\x7a\x99\x49\xbe\x24\x08\x1b\x49\x33\x05\xa3\x91\xe1\xf3\xaa\x53\x09\xdb\xf7\x52\x66\x9b\xd4\x14\x87\x5d\xbf\x2f\x71\x53\x62\x09\xdb\x90\x4c\x20\x2a\x8a\x4e\xef\xcf\x39\x4f\x0b\x2e\xb1\xc3\xe0\x08\x66\xb7\x5d\xdd\xfd\x87\x65\x26\xbf\x0c\x76\x14\x75\x8b\xba\xcb\x4f\xd9\x7c\xb0\x03\xdc\x40\x6a\xc8\xff\x2e\x67\xd4\x90\x93\x65\x36\xef\xf6\xff\x1b\x62\x61\xa0\x3f\x62\x89\x14\x86\x9b\x4d\x41\x90\xb1\x3a\x5d\x3f\x6d\x44\xd3\xa5\x07\x86\xea\x67\x01\x24\x49\x0d\x20\x0b\x82\x22\xc5\xe6\x00\x67\x6f\x41\x6c\xe6\x87\xdd\xed\x63\xb2\x31\xc9\x32\x31\x09\x94\xe3\x8f\xd6\xdc\x2c\x2c\xb9\xae\x2a\x1a\x14\x57\xbf\x72\xa5\x72\x28\x8a\xff\xc6\xc7\x84\xac\x79\xce\x57\x60\x2a\x56\xc0\x27\xaf\xc9\xff\xca\x41\x4f\xc9\xf8\x4f\x57\x32\x5b\xad\xb3\x14\x52\x53\x5c\xb5\xed\xae\xbe\x2f\x07\xb8\x4e\x3f\x70\xb3\x18\x9f\xda\xeb\x23\xdc\x24\xc8\x81\xd7\xe9\xff\xd9\x40\x7e\x57\xf6\x9b\x83\xa9\xa7\xad\x19\xab\x1e\x6e\x87\xb1\x08\x29\x36\xab\x15\xcf\xef\xa6\xe4\x23\x98\x3c\x81\x1b\x68\xb8\x4a\x81\xe1\xc9\xb2\x6a\xb6\x83\x92\xdf\xaa\x87\x84\x24\xa9\x5c\x6e\x14\x14\x64\x26\xf8\x92\xa7\x12\x66\xaf\xc8\x0c\x52\xc8\xe7\x77\x33\xc2\x53\x45\x66\x0b\x5e\xbc\xc9\x14\x3e\x17\x77\xcd\xd0\xb3\x0a\x57\xb3\x09\xf9\x3e\x6d\x9e\xde\x26\x66\xd1\x76\x20\x02\xc8\x9f\x4d\xbe\x81\x3f\x93\xa4\x20\x9c\xc8\x2c\x35\x39\x97\x66\x32\x6a\x66\xff\x31\x29\x4c\x96\x27\x28\x49\xf5\x18\x25\xd0\x44\xf2\x14\xfb\xff\xb2\x81\x3c\x01\x45\xc4\x1d\x29\xd6\x20\x13\x7d\x97\xa4\x73\x32\xcb\x2b\x94\xcd\x6c\x83\x3b\x52\x98\x3c\x49\xe7\x93\x6a\xdc\x1c\x8a\x75\x86\xf2\xde\x62\x6d\xcc\x28\x1d\xb7\xff\xdc\x43\xc7\xfb\xbf\x76\x7e\x41\x30\x21\x6d\xb0\x5f\xfe\xc7\xd7\xeb\x65\x22\x39\x32\xd4\xd5\x3f\x8a\x2c\xdd\xfd\x95\x90\x42\x2e\x60\xc5\xf7\x9f\x92\x5e\xd2\x97\x6d\x8b\xab\x8a\x8e\xe3\x12\x1d\xeb\xac\x68\xe6\x54\xb0\xce\x41\x72\x03\x6a\x4a\x10\x81\x67\x32\xc2\xbb\x2d\xc8\x8d\x69\xf9\x40\xd6\x92\x79\x94\x0b\x4c\x46\x8a\x64\xb5\x59\x72\x03\x0d\x99\xc8\x0a\xcc\x22\x53\x44\xf2\xe5\xf2\x95\x25\x6d\xb6\x31\xa4\x80\x54\x21\x09\x3a\x7a\xa7\xd1\x26\xc4\xea\xeb\x9a\x0a\x84\x34\x7f\x5c\x9b\x71\x41\x36\x05\xe0\xfe\x80\x9a\xa4\x30\xc9\x0a\xa7\x9a\x73\x7c\xcc\xe7\x60\x39\x0d\x2c\xd8\x38\x60\x0e\xc5\x66\x69\x48\xa6\x91\x6b\x96\x7c\x53\x40\x4b\xda\x5f\x36\x50\x98\x1f\x32\x75\x37\x1d\xf5\xd2\x92\xe7\xf3\xcd\x0a\xf1\x5c\x8e\x99\xde\x24\x79\x96\xe2\x83\xa6\x39\x8e\x91\xe4\x7b\xb8\xed\xa5\xfb\x30\xd5\xfb\x69\x3e\x44\xf1\x37\x7c\xb9\x7c\xcb\x0d\x1f\x7f\x5d\x8c\x8a\x60\x7f\xb4\x24\x19\xef\x28\xcc\x3f\x4f\x0f\x38\xb7\x55\x6b\xed\x14\x0f\x53\x80\x0f\x60\x77\x22\xb8\x91\x0b\x64\x1b\xe4\xf8\x62\xd4\x83\xc0\x7e\x96\x6f\x39\xcf\xb2\x5c\x87\xb7\xff\x18\x7c\xf7\x03\xe2\xe5\x2b\x65\xbe\x06\xf6\x9a\x03\xbb\x2c\x38\x3d\x55\x75\xfe\x33\xf9\x52\xdc\x19\x38\x93\x21\x1b\x1d\xac\x60\xbd\xcc\xee\x90\xaf\x7e\x0f\x0d\xdc\x37\xed\x71\x5d\xdc\x19\xfe\x4f\x7f\xfa\x13\xf9\x7c\xfd\xe1\x53\x8b\x16\x44\xcc\x4c\x71\xc3\x67\x24\x49\x6b\xf1\x21\x22\x53\x77\x68\x0c\x98\x45\x07\x2d\xd5\xd8\xd5\xdc\x47\x47\x28\xb9\x75\x67\x88\x7c\x93\x9a\x64\xd5\x1d\x8a\x17\x45\x32\x4f\x41\x75\x8d\xeb\xdb\x45\x22\x17\xb6\x7d\xb3\x3e\xdc\xb1\xa0\x5a\x25\xa8\x3f\x84\x8c\xff\x01\xf6\x96\x7e\x6b\xfc\x0a\x29\x3b\x1d\xf5\x4b\xf1\xd7\x66\x92\xdf\x6f\x8a\x25\x9a\xf0\xf4\x6e\x42\x7e\x84\x1c\x2a\xa6\x55\x80\x32\x73\xc0\xec\x93\xaf\x8c\xd2\x99\x82\xa3\x34\x46\x37\x80\xcf\xe1\xea\xd7\x2f\x70\xf7\x7b\xfb\x5f\x9f\xca\xb9\xff\x0a\x77\xcf\x85\x4b\x2a\x6c\x90\x1b\xbe\xdc\xdc\xc3\x2e\x3a\xcb\xc9\x3c\xb9\x81\x94\x7c\x81\xbb\xaf\x8c\x23\x2a\xc4\x97\x4c\xd1\xd9\xce\x8a\xab\x5f\x13\xf5\x70\x2e\xf8\xbc\xbd\x7e\x7b\x2e\x25\xf9\xed\x0e\x11\x4f\xe8\xf2\x23\x70\x75\x6e\x9f\x0f\xe5\xd6\x7d\x2a\xbf\x1c\xc4\x80\xfa\x78\xa6\x83\xb7\x51\x0f\x65\x5b\x4e\x11\x77\xe4\xfa\xed\x84\xfc\xbc\x80\x94\xcc\xd6\x25\x24\x33\x54\x2c\x68\x26\xbd\x22\x9c\x54\xcf\x88\xd9\x5a\x5b\x83\xa4\x9b\xe5\x92\xcc\x56\x80\x3b\xf0\x2a\x99\x2f\x0c\xee\x99\x39\x98\x4d\x9e\x82\x7a\x86\xac\x96\xa5\xf0\x5e\x1f\x3e\x46\x4c\xf2\xe5\xb2\xff\xa7\x63\x44\xab\x59\xf4\xf3\x76\x3c\xea\xe9\x44\xd6\x79\xb6\x86\x1c\xc3\x49\xfd\xa3\x12\xf4\x9e\x7b\x60\x3c\xb4\x13\x34\x5f\x16\x30\xea\x69\x72\xaf\xf8\x7c\xde\xfe\x3b\xb4\xfb\xfd\x85\x16\xfc\x91\xdf\x7e\x9d\x6b\xde\x63\xb3\x9c\xdf\xf6\x88\x46\xfb\x85\x2d\x5f\xad\x97\x95\x5d\xb1\xfb\x4d\xd4\x94\x8c\xe9\xd6\x53\x10\x3a\x9a\x29\x3f\x8a\x38\x8f\xb8\x03\x9c\x52\x0d\x91\xeb\x30\x15\xb3\x38\x08\x14\xf7\x98\xa7\xe2\xd8\x8d\xb9\xef\x38\x5a\x52\x01\x91\x03\x81\xaf\xb9\xf2\x19\xd7\x51\x1f\x90\xd6\x3c\xff\xcc\xe7\x53\xe2\xf4\xfc\x6a\x4d\xf8\x8f\x76\xf1\x74\x4b\xcb\x8f\x53\x8f\xdd\x37\x1c\x6c\xd7\x49\x6e\xdd\xc4\x29\x71\x69\x4f\x83\xd2\x60\x2f\xa6\xe4\x3f\xff\xab\xe7\xd7\x39\x2f\x3e\xe4\x89\x84\x37\x19\xce\xe9\xb0\xa8\xbf\xcd\x94\x30\x87\xd2\xbe\xe1\xb3\x3c\x99\x27\xa9\x05\x37\xf4\x83\x50\x45\xae\x08\x45\xa4\x22\xca\x95\x92\x82\x45\x0e\x0f\x1d\xe5\x7b\x5a\x86\xc2\x75\x03\x4f\x6b\x50\x7d\xcb\x50\xb0\x84\x39\x37\x59\x3e\xb5\x3a\xa7\xa7\x45\x9a\xa5\x12\xec\x3c\xfb\xb8\xef\x1f\x0f\x55\x59\xf1\x3e\x3d\x3a\x5e\x91\xfc\x7f\x98\x12\x27\xa2\xa3\x73\x98\xd8\xd2\xe7\xfa\xed\x0e\x79\xa4\xe7\x47\xb1\x17\xc7\x91\xcf\x03\x15\x05\x22\x74\xdc\x38\x88\xa9\x88\x22\xc7\x51\xca\x15\x5e\xe0\x85\x92\x32\xe5\x69\xcf\x91\x0a\xb4\x08\x95\xcb\x5c\x16\x8e\x8f\xcf\xf0\xb7\xcd\x4a\x40\xde\xcf\x22\x55\x93\xcf\xc9\x0a\x0a\xc3\x57\xeb\x29\x71\x7c\xe6\x3a\x7e\xc0\x42\xa7\x7f\x1b\xbd\xca\x41\x42\xb2\xae\x74\x6c\xbb\x19\x4d\x47\x43\xea\xe0\x71\xdb\xe9\xc1\xde\x78\xc1\x4d\x8e\x54\xeb\x19\xf5\x08\xfd\xfe\x66\xf7\xfc\xf6\xa8\xa3\x7a\xf9\xf5\xa0\xda\xfb\x58\xae\x79\x3c\x1a\xd0\xc9\xf5\xa3\x1d\xc7\xfc\x14\xb6\x3e\x61\xe2\x52\xe9\xee\xf3\xd7\x61\xf4\xe5\x1c\xe2\xbe\xc9\x56\xab\xc4\xf4\x28\xe9\x23\x24\xc5\x20\x00\xbf\x9d\x0c\x39\xeb\xff\x3c\xef\x7b\x67\xdb\x7c\x46\xfc\x36\x04\xf3\xe7\xff\xb8\x7e\x5b\x12\xd5\xea\x94\xe2\xea\xd7\xfa\x58\xe5\xe1\xb6\x77\xeb\x12\x9d\xa5\x30\xde\x6d\xd7\x3c\x55\x70\xb2\xd2\xe8\x1c\x6f\xf6\xa9\x0b\xbb\x9e\x51\x0f\xa2\xf7\x14\x04\xc1\xc3\x5b\xab\x6d\x5f\xe1\x9f\x63\x01\x85\x19\x5b\x97\x0a\xa3\x70\x85\x29\x95\xed\x84\x5c\x6b\x32\x83\x0a\xc4\xfa\xc8\x29\xb3\xb4\xeb\xd8\xcf\xcb\x65\x97\x97\x0b\xc2\x97\x59\x3a\xb7\x96\x74\x33\xa9\x59\x40\x92\xd7\x0a\xac\x20\xb7\xc9\x72\x89\x36\x35\xac\x04\x28\x05\x8a\x6c\x52\x05\x39\x99\x75\x87\x99\x11\x9d\xc0\x52\x91\x24\x2d\x0c\x70\x85\xb1\xb2\x44\x15\xff\x22\xd6\xb7\x25\xf3\x78\xd4\xd3\xef\x9e\x8e\xd7\xc5\xe7\x7c\x93\x7e\x79\xa8\x1d\xdb\x25\xc0\xb1\x36\x7b\x88\xed\x74\x21\xd7\x6f\x8b\xba\xcd\xe1\xe7\xe8\x70\xe6\x6e\x0d\x78\x8c\x90\xf3\xbb\xa3\x6d\x12\x03\xab\x01\x88\xea\x41\xca\xe3\xd0\x81\x66\xb5\xf5\x8b\x96\x0c\x8b\x3c\x21\xb8\x4f\x41\x87\x61\x18\x45\xb1\xd6\x0e\x77\x83\x10\x14\x15\x6e\xa4\x7c\xf0\x03\x16\x84\x8e\xe7\x85\xa1\xf4\xa8\x02\x37\x52\xa1\x23\x41\xa9\x40\xc7\x9a\x7b\x61\x38\xfe\x97\xa5\x79\x23\xb7\x47\xe4\x7e\x4f\xde\x9f\x96\xf2\x03\x08\x3f\x0d\x7f\xc7\xdc\xbe\xd3\x7a\x1f\xb5\x50\x0e\xb1\x56\x29\xd2\x4a\x4b\x8f\xfa\x39\xf3\x60\x9c\xb4\xb2\x8a\x5d\xe6\xbb\xcc\x1b\x1d\x71\xda\x28\xa5\x9e\x0e\xa4\x8c\x22\x21\xbc\x80\x05\x3c\x66\x31\x0d\x43\x27\x82\x88\x69\xe6\xfb\x22\xd2\xe8\xad\x79\xbe\xcb\xc3\x08\xa2\x30\x0e\x41\x44\x12\xb8\xeb\xc6\xae\x60\x8e\x7f\x08\x7f\xe9\x2a\xb8\xa1\x7b\xf0\xcb\x9a\xe7\x90\x9a\xd6\x1f\xc0\x89\x45\xe8\x52\x25\x54\x4c\x35\x28\x1a\x2b\x27\xf0\x85\x56\xda\x75\xa5\xa4\x00\xca\x0b\x41\xd2\x20\x8a\xdd\x48\x07\x00\xa1\x08\xa5\xc3\xb8\x07\x3c\x8e\x7a\xfc\x22\xd3\xb5\xf1\x5d\x97\x05\x61\xdc\xe3\x84\xcd\x79\xf1\x53\xb2\x4a\xcc\x94\x38\x0e\xf3\x5d\x3f\x8c\x0f\x9a\x08\x48\x41\x27\x32\xb1\x7b\xe4\x98\x6e\x85\x47\x63\x4f\x32\x5f\x47\x81\x0a\x58\xa4\x95\xf2\x43\x87\x6b\xe9\xd1\x30\xd4\x54\x51\x27\x0e\xb8\x16\x5e\x8f\x03\x3b\xe7\xc5\xff\x2d\x40\x1d\x73\x08\x4d\x66\xf8\xf2\x93\xcc\x72\xf4\xad\x28\x8b\xe3\xe8\xd0\xa3\x34\xdb\xe2\x63\x96\x19\x8b\xb3\x28\x56\x5a\xc5\x5a\x2a\x87\xca\x18\x7c\x57\x05\x91\x1f\x33\xa9\x23\xe1\x7b\x54\xb0\x88\x8a\x90\x29\x37\x72\x44\x14\x44\x3e\x73\x19\x73\xe3\x98\x69\x17\x68\xcc\x23\x1a\x08\xd1\x83\xb3\x6d\xf1\x17\xe0\x66\x93\xa3\x3d\x7c\x08\x20\x66\x1f\x41\x3b\x7d\x20\xa4\x0c\x14\x73\x3c\x21\x63\x15\x29\xaa\x40\x09\xee\x50\x87\xf1\xc0\x95\x91\xeb\x84\xca\x89\x25\xc4\xa1\x0e\xa8\x8c\x38\x03\xed\x4b\x3f\x16\x42\x79\x54\x79\x2c\x70\x0e\xa7\xaf\x25\xbd\x99\xc2\xf1\xc3\x28\x04\xe6\xbb\xae\xf4\x42\x0a\x11\x0f\xa2\x08\x02\xa9\x9c\x90\x3b\x00\x0e\x53\x91\xe7\xa3\xd6\x55\xbe\x8e\x98\x62\xd2\xa1\x31\x30\x15\x30\x16\xa8\x08\x7c\xaf\xc7\xe9\xb7\xc7\x5b\xb9\x1d\x9c\x8b\x50\xb0\x50\xcb\x18\x42\xc5\x62\x1d\x6b\x06\xbe\x50\x6e\xe0\x84\x5e\xc8\x7d\xdf\xf1\x15\x95\x92\xa9\x1e\x38\x93\x52\x55\xee\x99\xc9\xa7\x6a\xc2\xd7\x97\xd9\x35\x7a\x0d\xcf\xab\x05\x70\x05\xf9\x45\xed\xcf\x47\xda\x91\xa4\x04\x69\xd4\xa3\xca\x1e\x68\x4e\xbe\x4f\x97\x77\xb8\x37\xec\x8c\x8f\x96\x64\x1d\x5c\x6d\xd2\x6d\x46\x7d\x34\x79\x65\x75\x12\xce\x60\x90\x8a\x55\x4e\xdd\x33\x34\x09\x87\xf6\x0b\xbb\xcd\x63\x9c\x00\xf2\x92\x15\x30\x9b\xf0\xca\xe6\x18\xde\xef\x56\x36\xa9\x8a\x1d\xa2\xfd\x25\x59\x1a\xc8\x89\x1d\xa1\x4e\x4d\x1c\x20\xd8\xbb\xa6\x1d\xe1\x39\x60\x3c\x57\x6d\x64\x99\x68\x36\x7b\xff\xe1\xff\xfd\xf4\xfe\xdf\xec\xb1\xf3\xbb\xbf\xff\xfb\x33\xf5\x38\xed\x02\xca\x45\x8f\x9f\x1f\xe9\x87\x4c\x9a\xa3\xa6\xcc\x83\x6d\x46\x8b\x8b\xf1\xe8\x7c\xb3\xef\x78\x50\x64\x18\xf9\x3f\x65\xf3\x36\x24\x82\xcc\x76\x55\x67\xc5\x3e\x8a\x79\xf7\x53\x6b\x07\xf8\xf7\x73\xb7\xa9\x65\xe1\x1c\x64\x96\xa3\x5d\x95\xa5\xe4\xef\xef\x3e\x37\x79\xba\xbb\x99\x91\xcf\x8a\x87\xeb\x45\x7c\x63\x63\xcb\xc6\x35\x3a\xfe\x69\x9c\x8c\x29\xda\x57\x69\x99\xa5\x7f\xb5\x86\x66\xe3\x1d\xd8\x41\x9b\xac\xef\xbe\xfd\x53\x66\x69\x0a\xd2\x80\x22\x76\xb0\xe7\x47\xdf\xa3\x34\x1c\x42\xd9\x07\x80\xfc\x93\xe1\xa6\x28\xc5\xbf\xe8\x26\xaf\x5f\xd9\x2d\xfd\x5e\xac\x1d\x26\xbc\x77\xd0\xf7\xf2\x67\x10\x45\x26\xbf\x80\xf9\xae\x93\xfa\x9e\xc2\x6d\x9b\xb3\xdf\x6f\x1d\x9d\x60\x1f\x7d\xc8\x8a\xc4\x58\xfb\xa8\x13\x68\x23\xe4\xf9\x51\xe6\xa8\x10\x3d\x30\x7a\x30\xdc\xed\xbd\x28\xb2\x25\x98\x1e\x7b\x7b\x58\xee\xee\x33\x95\xf7\xd0\xd5\x69\x8e\x41\xa2\xde\x0e\x43\x2a\x67\x90\x65\x4f\x0c\xff\x5c\x36\xf4\x73\x28\x00\x1d\x13\xee\xf2\x02\x60\x07\x2f\x46\x3d\xa8\x6d\x77\xc6\x32\xdd\xaf\xe0\x26\x29\xf4\x1d\x91\x79\x62\x20\x4f\x38\x1a\x73\xbf\x20\xcb\xd7\x5b\x21\x21\x4f\x20\x47\xed\x5d\x14\xcc\x6c\x6a\x1e\xe2\x35\x8b\x69\x19\x37\x1e\x0d\xb3\xfe\x11\x02\xee\x2c\xb5\x4a\x9a\xc2\x50\xb0\xc5\x07\x81\x55\x62\x0c\xe4\x07\x30\x18\xfa\x44\x10\x98\x6c\x9d\x48\xda\x00\x70\x38\xb1\xf3\x94\x13\x3b\x03\x13\xb3\xa7\x9c\x98\x0d\x4c\xec\x3e\xe5\xc4\xee\xc0\xc4\xde\x53\x4e\xec\xed\x4f\xfc\xf5\xef\x10\x47\x7d\x85\xa7\xd9\x21\x8e\xdb\x65\x27\x59\x65\x75\xe3\xfa\xd3\x19\xe9\x50\xf5\xd6\x16\xff\x53\x69\xdf\x7a\xfc\xcb\x28\xe0\xa7\xd1\xbb\x66\xfb\xde\x26\xa5\x3c\x91\x54\x94\xe1\xb7\xae\x0a\xc6\x6c\x39\xbb\x60\x64\x6e\x9e\xa4\x65\x72\x7b\x8d\xaa\x03\xf8\xf0\xfe\x14\xe4\x4f\x04\x5d\x17\xac\xec\x0b\xa4\xfb\xb3\xd5\x40\xe4\x20\x93\x75\xd2\x55\x27\x4f\x0c\xc7\xfe\x84\x5f\x83\x1a\x79\x8c\xb7\xf6\x4c\xb5\xc9\xa1\xca\x10\xc0\xcd\x53\xa8\x8b\xce\x6d\x94\x71\x41\x70\x96\x93\x94\x46\x25\x43\xf5\xe8\xb8\xfb\xb4\x7e\xcf\x2b\x7b\xeb\x42\x2c\xb3\x6c\x45\xb4\x8d\x18\xa0\xac\x71\x4c\xaa\x5f\xad\x51\x2f\x80\xb2\x71\x53\xc2\xb5\x2e\xbd\xce\x8a\x0f\xa1\x78\x0a\x9d\xf3\x47\xe0\xe1\x1f\x80\x9b\xf1\x03\xfa\xb5\xfc\x8b\x2c\xa5\xf0\x82\x35\x46\xc0\x64\x83\xd8\xa1\x00\x58\x7b\x4d\xbb\xc3\x46\x6f\x72\xc0\xfb\x48\x1c\xc3\x56\x12\xf2\x1e\x66\xa9\x1e\x95\x29\xef\xf5\x5d\xa4\x67\x1b\xd7\x92\x90\xbf\xb7\x74\x1f\x57\xbb\xf5\xf3\xe3\x9a\x52\x93\x97\xa5\x07\x3a\x74\xac\x2e\x1f\xbc\xce\x79\x3a\x87\x07\x52\xb3\x89\x00\xd5\x37\x19\xec\x60\xa3\x9e\xe5\xb5\x1a\x00\x37\x8b\x45\x7b\x07\xc2\x4a\x72\x79\xb3\xa1\x12\xe3\xe7\x49\xeb\xea\x12\xc3\x47\x5c\x60\x45\xf1\xe7\x47\xea\x53\x17\x30\x1e\x8d\xda\x16\x38\x4c\xd5\x08\xff\x24\xf5\xfd\x95\xe9\xe8\xf8\x56\x55\x15\x11\x98\x8e\xf6\xd9\x6c\xd8\x60\xa8\xba\xa1\x93\xbe\x49\x13\x43\x7e\x7e\x77\xfd\x8a\xac\x73\x28\x20\x6d\xb4\xfa\x02\xb6\x87\xa3\x74\xa3\x19\x5e\xa8\xb5\xa3\x63\xea\xb2\x90\x73\xaa\xa3\xce\xee\x5a\x16\x34\x38\x17\xaa\xb2\x97\x05\x2a\x49\x1f\x08\x94\xd4\x01\xf3\x1c\x3f\x52\x7e\xec\xb8\x71\xe7\x4c\xbd\xaa\x92\x70\x08\x93\xc8\xb2\x25\xf0\xf4\x18\x50\xb7\x0b\x30\x0b\xc8\x77\x64\x65\xc1\x8b\xee\xcd\xb2\x1d\x18\xca\x5c\x7b\x3b\x5a\x77\xbe\x3e\xe2\xc9\x5e\x78\x06\x97\x17\x50\xfc\x7a\xd4\x67\x01\xa5\x34\xa2\x5a\x51\xca\x9d\x00\xf3\x91\x79\xc8\x43\xe6\x52\x3f\x62\x54\x32\x57\xb9\x1c\x98\x92\x51\xc0\x95\xe3\x52\x3f\x70\x38\x8b\x58\xac\xa2\x50\x86\x52\x44\x9e\xeb\xbb\x81\xef\xc5\x4c\x28\xc7\xf7\x22\x10\x21\x84\x5a\x52\xed\x06\x2e\x13\x10\x53\xca\xe2\xaa\x4c\x42\xc5\xad\x43\xcb\xb0\xb7\xa6\xce\x5c\x47\x95\xce\xfd\xd0\xaf\x53\x41\x57\x5e\x4f\x98\x8e\x7a\xe8\xd6\x8d\xfd\xa1\x19\x57\xd7\x40\x39\xb6\x8a\x3a\xd9\xfc\xfe\x75\xec\x4c\x63\xbb\x91\x44\x41\x6a\x12\x9d\x40\x4e\x5e\xe2\x7d\xc1\xc2\x65\xdf\x1d\x5f\xf9\x85\x32\x66\xba\xc9\xeb\x9d\xc9\x4a\xec\x27\xa9\x81\x79\xc7\x15\xb1\xdb\xf8\x8a\x9b\x29\xd9\x24\xa9\x71\xd9\xf0\x7a\xca\xe4\x1f\xf2\x72\x01\x78\x0f\xa9\x77\x29\x7b\x79\x41\x7b\x69\xf2\x67\xc2\x13\x78\xc3\xf0\x6c\xd2\x64\xdb\x26\xe8\xf4\x81\xd3\x49\xd9\xb1\x3f\x77\x12\xa9\xa7\xa3\x9e\x71\xcd\xb6\xce\x1e\xf9\xc6\x1d\xff\x52\xdc\x51\xff\x66\xb6\xe7\x93\xb3\xab\x53\x5a\xa2\xf6\x4d\x78\x91\x60\x7f\x3d\x6a\x1d\x63\x79\x0c\xb8\xe5\xd5\x21\xf2\xb2\x0c\xa8\x1c\x63\x3f\x25\x3c\xca\x42\x2f\x0c\x05\xe3\x91\x06\x4f\x46\xae\x0c\x14\xd7\x10\xea\x28\x08\xc2\x48\x08\x47\x44\x1c\xb3\xe7\xec\x00\x95\xa3\x3b\x1d\xf5\x4c\x6e\xa3\x98\x24\xdb\x3d\x64\xff\x26\x6b\xdf\x64\xed\x9b\xac\x9d\x2b\x6b\x75\xef\xd2\x05\xbf\x4e\x15\x6c\xcf\x25\xeb\x71\x36\x4b\x70\x38\x0c\xfc\x94\xa3\x57\x81\xa1\x39\xda\xe2\x98\x44\x47\xcc\x22\x29\x50\x74\xfb\x56\x51\xed\xb5\x3f\xb4\x67\xf0\x07\xb1\x91\xd7\xa7\x67\xa5\x1d\xd7\x08\x98\x7f\x77\xb9\x15\x7f\xfc\xe9\x03\x81\x14\x8d\x6f\x55\x31\x35\x8e\x8f\x6e\x87\x55\x18\x7d\x0b\xc5\x74\xe4\x76\xa9\x3f\x76\x72\x26\xfb\x01\xae\x92\xa7\x2f\x06\xf2\x43\x75\x41\xa2\x0e\x61\x38\xe0\xe3\x1a\x84\x4a\x5d\x0e\xc3\x70\xaf\x28\x5e\x4e\xab\x36\xf9\xde\x17\x5b\x42\x39\x62\xb5\x92\xeb\xb7\xc3\x2b\xb8\x40\x6a\xb9\x79\x56\x5a\xb8\x49\x5d\xbf\x30\x30\x58\xd3\x68\x89\x03\x93\x97\x2b\xbe\x45\x05\x90\xdd\x82\x42\x67\x79\x63\xcb\x2b\x25\x37\xdd\xba\x47\x99\xee\xea\xca\xa2\x97\x8b\x0f\x52\xeb\xbb\x29\xf5\x17\xe3\x86\x2a\xcc\x85\xba\xaf\x76\xec\x4d\x56\x7a\x05\x4d\x2e\x72\x0e\xb7\x3c\x57\x7d\x30\x3e\x28\xb1\xbf\x4e\xe8\xbf\x18\x05\x4e\x43\x72\x1f\xfc\xbb\x57\x0a\x3a\x57\x09\x2e\x06\x5b\xb1\x59\x21\x20\x7c\xb9\x24\x58\xe8\xb2\x30\x39\x5f\x56\x47\x0b\x63\x52\xe0\x5c\x7d\x70\xed\x5f\x64\xa8\x2f\x30\x5c\x8c\xec\x79\x96\x19\xb2\xe0\xc5\x62\x1f\x4b\xb8\x03\xb4\x59\xe2\x7d\xb0\x5d\xf4\x0e\x45\xf7\xee\xc4\x99\x38\x3f\xbe\xb8\x62\xb3\x5e\x67\x39\xc6\xf0\xcc\xb6\x20\xba\x1a\x9f\x88\xc4\x14\x60\xfa\x96\x44\x47\x87\x97\x35\x9e\x06\xd5\x95\x8c\x15\x36\x7f\xbe\x97\xf4\x17\xbd\x23\x52\x79\xf7\xbf\x13\xf3\x34\x57\x51\x8e\xac\xeb\x72\x17\x53\xaa\x0b\x29\x67\xae\x88\xd1\x63\x2b\x42\x8e\xcf\x52\x20\xb7\x0b\xac\x87\x57\xd6\x72\x43\x93\xaf\x7b\xa7\x77\x7f\x35\xa7\xdf\x84\xb1\xb3\xbe\xb1\x96\xe5\x90\xbd\x64\xb2\x13\x16\xb4\x03\xf6\xb8\x39\xe2\x6e\x6d\xd7\x57\xb6\xfc\x04\x4a\x4a\x6f\x65\xbd\xc6\x1f\x1c\x1f\x59\x96\x4f\x5d\x8f\x73\x3f\xa6\x0e\xf3\x45\xe0\x51\xe6\x72\xca\x02\xe6\x38\x4c\xc4\x91\x0a\x19\xb8\x32\x02\x8f\xc2\xf8\xec\xd0\xe7\x0e\xe8\x0b\xd8\x22\x8c\xab\xf6\xb8\xbe\x2c\x8f\x57\x3b\xca\x39\xa8\x23\x00\x7a\xa1\x56\xc2\x95\xae\xf6\xfc\x40\x62\x1c\xb4\x85\x04\x0b\xf7\x9d\x0b\x48\x92\xae\x37\xc6\xf6\xac\x7c\xe5\xde\xcd\x78\x4c\xb7\x15\x1d\x3f\x6f\x87\x68\x98\xa8\xb3\xe7\x6f\x6c\xc9\xfa\x30\xaa\x23\x51\x47\x40\xb9\x9c\xa7\x57\x15\x53\x39\x13\xe6\x5e\x71\x39\x05\xf0\xf3\xdd\xbd\xb6\x4c\xcb\x03\x60\x6c\x3a\x5b\xc1\x5e\xf3\x44\xd9\xc7\x68\x87\x69\xe8\xd5\xbe\x3b\xa5\x5b\x2e\xeb\x71\x21\x73\x55\x97\xa8\x0e\xe8\x5c\xa6\x14\x24\x45\xd7\x2d\xeb\x03\xcf\xe9\x14\xdc\x69\xca\xfa\x9c\x09\x61\x74\x0c\xc0\x25\xc7\xe2\x03\x08\x65\xa6\xad\xef\x5b\xd4\x1a\xf0\x88\x9b\xe0\xc6\xa3\x83\x2a\x42\x67\x52\x29\xb2\x13\x16\x78\xc2\xa5\x93\x2d\x4a\x40\x91\xad\x60\x70\xd6\x1e\xe7\x64\x3c\xea\x29\x4e\x74\x26\x5a\x8e\x13\x6e\xdc\x0e\x4a\x72\xa8\xcc\xcc\xba\xf8\xe9\x47\xd0\xaf\x9a\x13\x44\xb1\x9f\x08\xde\x00\x1d\x76\xf6\x9e\xba\x3e\xd2\xe8\xbe\xfc\xeb\x9e\xac\xeb\xa1\x38\x42\xb9\xc3\x8c\x47\xbd\x95\x96\xce\xc4\xc6\x51\x26\x91\x19\x68\x74\x42\x70\xcf\xd9\x14\x28\xf8\x19\x16\xeb\x96\x1b\x2c\x5e\x61\xd9\x5a\x27\x29\x5f\x5a\x33\x7c\x8d\xb3\xf7\x61\xa3\xc5\xc5\x9c\x17\xe7\x82\x76\xdc\xd6\xb6\x8e\xd7\xca\xfa\x30\xc8\xc1\x78\x3e\x59\x16\x74\x97\x59\x5a\x6c\x56\x25\xb0\x50\x15\xc8\xb5\x21\x9d\x7b\x34\xd6\xae\x7b\xd0\x56\x78\xba\x9f\xc9\x4f\xb4\xa4\xae\xdf\xf6\x29\x83\x2c\xed\x96\x8f\xdd\xe4\xd6\x5f\xef\x36\xa8\x20\x21\x59\x3a\xa9\x97\x88\x8a\x6b\xd2\xb7\x86\x1d\x8d\x56\x96\xb4\xba\x1f\xfc\xa6\x37\x6e\x36\xb1\x64\x7e\x08\x6e\x00\x3c\x80\x90\x61\x46\x97\x1d\xc0\x56\x9f\x19\xda\x0b\x73\x7e\x7b\xc2\x54\x47\xad\x82\x4a\x0d\x76\x31\x73\x04\x42\x1d\x05\x71\xe4\x08\x1e\x51\xca\x15\x57\x71\xec\xd5\x47\xa4\x43\x9f\xd0\x0b\x74\xc4\x58\xe8\xd0\x88\x52\x27\x62\x3e\xa3\x11\xfe\x25\xa9\x88\x3c\xc7\x0b\x63\x26\x63\xcf\x8d\xfd\xd8\xa3\x71\xe4\x32\x37\xa6\x14\x02\x2f\xa4\xa1\xc7\xa4\x8a\xc2\x10\x64\xac\xe3\x98\x06\x42\x72\xea\xfb\x0e\x05\x8f\x39\xda\x15\xd4\x71\x41\x31\xe6\xb8\xcc\x83\x30\x94\xdc\xa1\xca\xf5\x82\x40\xb8\x4c\x38\x11\xa5\x32\x64\xe0\xb0\xd0\x89\x05\x73\x5c\xed\x28\x4f\xba\x21\x75\xa9\xef\xc6\xb1\x52\x2c\xe4\x3a\x0e\x58\xc0\x02\x8f\xd2\xca\xde\x78\xd7\x5e\x6d\xe8\x47\x73\xe5\xc1\x9f\x8b\x6a\x64\xba\x8e\xf3\xdf\xd8\x8a\x25\xe7\x55\x57\x53\x31\xd7\x0d\xaa\x84\xff\x97\x95\x0d\x7d\xcc\x3e\x3a\xbf\x18\x9b\xcd\xf6\xee\x01\xfc\x04\x3d\x78\x64\x85\xbb\x10\x5d\xae\x98\xde\x89\x86\xe5\x65\x27\x1f\x75\xaf\x5c\x0e\x71\x40\x99\x70\x7b\x02\x7c\x3b\x0c\x50\x13\xdf\x9a\x1e\x38\x04\xbe\x30\xe5\x0b\xa4\xc5\xc5\x6c\xb7\xc6\x3b\x79\x14\x68\x55\x2c\xea\x1e\xe8\xce\x77\x5b\xf8\x2a\xdb\x3c\x00\xb4\x66\x7f\x19\x04\xa7\xc7\x49\xe9\x9e\xc8\x0f\x51\xf3\x12\xe1\xb1\x23\x3b\x18\x5a\x04\xfc\xee\xe1\xac\xd2\x09\x12\x36\x06\xb5\x35\x02\xe6\xfc\x72\x5c\x83\xa3\x3e\x66\xdf\x68\x29\x84\x23\x55\xf9\x54\x47\xa0\x73\x98\x1b\x80\x96\x42\x0a\xe1\x7a\xbb\xbe\x64\x19\xf4\xbc\x0c\x20\x83\x01\x54\x3f\x0c\xc0\x89\x62\x8d\x27\x06\xfb\x20\xdc\x00\x86\xb1\xce\x4e\xd7\xc2\x74\x44\xb2\x02\x9e\x16\x07\xb6\xc5\x2d\x2f\x9a\x71\xfb\x00\xda\xad\x92\x9a\x6d\xcc\x7a\x63\x8a\x43\x00\x4e\x50\xd1\x7d\xbc\x5d\x19\xc0\xd5\x5e\xf3\xfd\xe1\xce\x35\x88\xe9\xc1\x4c\xce\xf6\x5b\x57\xd8\x6f\xe3\x1f\x15\xff\xbe\x22\x89\xae\xaa\x9b\xe7\x65\x22\x35\x96\x23\xae\xe2\x26\x58\x0a\x83\xf7\x8c\xd6\x17\x44\xd9\x49\x03\xee\x41\xe2\x8e\xcd\x55\xfd\x76\x53\x67\x37\xee\x7e\xfb\xd1\x79\x14\xa9\xf7\x7b\x01\xbd\x57\x8d\xea\xa8\xca\xef\x01\x40\x7b\x45\xc1\x8e\x57\xbf\x22\x60\x3a\x3a\xce\x16\x0f\x8a\x20\xb5\xe2\x75\x5f\xfc\xe8\x91\x61\xa1\x9d\x50\x1a\xbe\x98\xe8\x09\xbd\x97\xea\xd8\x08\xbd\x27\x9c\xb6\x79\x47\xcc\x81\x53\x77\xee\x7a\x38\x26\xcf\x6f\x0c\xf4\x38\x66\xb8\xa4\xf3\xf7\x84\xb2\x57\xb3\x35\xbc\x5c\x15\xf3\x09\x5a\x11\xed\x59\x7f\x2d\x0e\xcd\x08\x25\x99\xed\xae\x00\x54\x04\xc2\xe5\x61\xb0\xa7\x75\x11\xe1\xa5\x56\x0c\x02\xdf\x73\x83\x28\x70\x82\x38\x00\x46\x7d\x2f\x88\x02\x1d\xb2\x0e\x57\x95\xef\x66\x19\xe2\xab\x87\x10\x1e\xf5\x43\xa9\xf6\x6c\x50\x70\xd4\x23\xde\xb8\x71\x50\xd7\xf7\x03\x1e\xba\xd2\xa1\xe0\x46\x5a\x03\xd3\x12\xe3\xa6\x54\xcb\x58\x79\x01\x57\xd4\xf1\x22\x4d\x43\x60\x81\xe7\x84\xe0\x38\xa1\x50\x0e\x48\x88\x55\xec\x45\xa2\x73\xc0\x7b\xa8\x18\xfa\x25\xb2\x47\x16\xcf\x50\x03\xbd\x0a\xe0\x22\x13\xb5\xe2\x7e\x49\x03\x66\x87\x24\xc8\xb2\xd6\xcc\x50\x1b\xa4\x5c\x8f\x54\x1c\xb5\x78\xce\xd9\x42\x8f\xec\x81\x37\xab\x77\x79\x7e\x52\xfc\xb1\x19\x60\x5c\x71\xe9\xce\x2b\x90\x86\x18\xf5\x77\x0c\x09\x5d\x8e\x2c\x7f\x38\x85\x65\x69\x73\x03\xea\xe7\x2c\xff\x72\xee\xe8\x66\x5b\x75\x26\x58\xc7\xe4\x65\x89\x0b\x03\x29\x16\x18\x6b\x76\x8f\xef\x1e\x6d\x89\x23\x9e\xd7\xd8\xf1\xde\x19\x9e\x22\x12\x6a\xb6\x9d\x00\xeb\xbd\x10\x3c\x34\x26\x5c\x27\x1d\x68\xc8\x21\x95\x70\xcf\x3c\xb5\xd0\x0d\xc9\xd2\x6b\x62\xb2\x07\x7a\x89\x27\xee\x5b\xa7\xed\x5d\xed\x79\x3a\x0a\x22\xf1\xe9\xbe\x73\x86\x6c\x3e\x25\x63\x54\x61\xdd\xcf\x78\x9f\xf5\x1f\x16\x6f\xe9\x70\x77\x39\xc7\xf8\x90\x1f\x71\x64\xbc\x36\x11\x46\x8c\x31\x01\x5c\x09\xea\x46\x8c\xba\x02\x98\x03\xca\x97\x10\xca\x58\x38\x42\xeb\x80\xb2\x71\x1f\xb3\x91\x1d\xfd\xdb\xf0\x40\x95\x53\x64\xff\x8b\x7c\x47\x72\xed\xca\xb6\xff\xae\xb6\xdc\xdd\xd8\x0f\x15\xe1\x9e\x12\x1c\x54\x80\xcd\x70\x75\xd8\xf2\x2f\xf6\x12\x67\x79\x69\xaa\x18\xd2\xc9\x99\xd6\x05\x98\x43\xe6\x3d\x14\x9e\x46\xef\xd3\x63\x2c\xbd\xeb\xa6\x94\x23\xe3\xa9\xc1\x0a\x97\x0c\x0a\x0f\xce\xb3\x5c\x91\x6e\x7a\xc2\xf2\xd4\x2c\xa5\x66\x76\xe7\xc4\xe9\xed\xc8\x68\x37\x97\xb3\x62\x60\xa5\xb2\x78\x46\x83\x7d\xd7\xbc\xb0\xfe\x64\x01\x9d\x2b\xad\xe8\x52\xdd\x65\x1b\x92\x02\xa8\xea\x82\xac\x5d\x0f\x52\x10\x55\xd5\x1c\xd4\x84\xc0\x64\x3e\x69\x79\x7f\x36\x9b\x35\x7f\xff\xda\xfc\x45\xc8\x8b\xb2\xba\x75\xf1\x62\xba\xf3\x18\x7f\xb0\x08\x7b\x31\x25\xf4\xd5\xee\x0f\x76\x29\x2f\x30\x5f\xa6\x66\xa2\xf2\xfb\xdf\xa3\xc3\xbf\xba\xd3\xa2\x91\xc7\x45\x76\x03\xa5\x9a\xa9\x22\x4d\x08\x6d\x43\x9c\x82\xd0\xf2\xee\x2f\xb6\xb5\xbf\xd8\xb3\xbb\xa4\x20\x0e\x6d\x03\xed\x16\x27\x15\xdc\x64\x86\x7e\xdf\xac\xc6\x88\xca\xd2\xb1\x29\xf1\x62\x32\xa2\x60\x85\x83\xad\xf9\xdc\x56\x46\xeb\xb0\xe2\xc7\xf6\xca\x63\x3f\x23\xe2\xd1\xd2\x21\x23\x1c\xe8\x50\x48\x37\xab\x6e\x33\x54\x7b\xfb\xf9\x0b\xf8\xcc\x24\x2b\x18\x75\xfb\xd5\xfc\xb3\xdf\x78\x80\x85\x14\xe8\x24\xb5\x79\xb1\x80\x69\x78\x36\x1e\x36\xd3\x79\xb6\xaa\x5e\xdf\x6b\xb2\x59\xf7\x30\x82\x90\x99\x1d\x7c\x56\x05\x25\xba\x99\x9c\xaf\xc8\x0c\x21\xda\xfd\xa9\xc9\xea\x7b\x45\x14\x68\x8e\xef\x65\x35\x59\x3d\xc8\xee\xc8\xcd\x3f\x70\xfa\x53\xe4\xe5\xa8\x71\xd3\x2b\xc6\x83\xd9\x19\x0f\x19\x1c\xd5\x63\x7d\x3f\xe7\x28\x8e\xbb\xf8\xb5\xb7\x58\x51\x46\x4b\xe9\x22\x49\x5a\x0a\x54\x2f\x63\xef\xc8\x93\xed\x79\x28\x4d\x48\xb0\x17\x53\xf2\xc2\x62\xf3\xc5\x9e\x44\x21\x16\xad\x40\xed\x3d\x37\xd9\x8b\x3d\xd5\x7e\xbf\x94\xd5\xb2\x95\x75\xd6\x81\xe3\x57\x44\x76\x68\xfb\x0a\x49\xc4\x4a\x67\x45\xa5\x20\x15\x86\x63\x54\x1a\xf7\x7f\x1c\x40\x63\x5e\x8b\x5d\x53\x0f\x07\x58\x7f\xe7\x4d\x55\xf6\x63\x48\x9a\x2a\xfb\xef\x90\x98\xc3\x46\x49\x6d\x36\xd6\xa5\x69\x0e\xea\x1f\xd9\xc3\x0c\x7a\xef\xb0\xb6\x99\x73\x5a\x33\x76\x5a\x33\xf7\xb4\x66\xde\x3d\xcd\x8e\xb0\x62\x53\x4a\xa5\xe5\x40\x7c\x25\xb3\x75\x5b\x27\xe4\xfb\xe5\xb2\x7c\x57\x40\x59\x0e\xf2\x1f\x59\x92\xd6\xb7\x54\x67\x3c\x55\x33\x82\x04\xc0\xb7\xec\x4c\x6a\xa2\xda\xd6\xb6\x71\x32\x4f\xb3\xfc\x8c\xed\xa1\x22\x01\xb2\xee\xf0\xdd\x49\xcf\x0f\xde\x05\x7e\xc8\x82\x30\x8c\x77\xf8\xfb\x85\xc5\x3e\x2d\x47\x50\x4a\x33\x9f\x71\xe5\x08\x60\x32\x8a\x45\x10\x4b\x26\x68\x10\x69\xe9\x86\x91\xe2\x3c\xf6\x99\xe0\xa1\x76\x02\x57\x7a\xdc\x71\xb0\x68\xb5\xef\x73\x4f\x69\x9f\xb9\xc2\x05\xfd\xe2\x1e\xee\x2f\xf7\xf6\xa2\x8a\xfc\x55\xfc\x52\xd6\x7a\xa5\x5b\xf0\x63\xe5\x85\x3e\x17\x10\xc4\xbe\x0c\x75\x10\xf2\x88\x33\x17\x4f\x10\x5d\x1e\xf9\x81\xa0\xc2\x93\xa1\xa3\x4a\x7d\x5a\xe2\xb3\x04\x7e\x46\xe0\x97\x0d\x5f\x16\x64\xf6\xf8\x25\x34\xaa\xb4\xd6\x4e\x0d\xf0\x15\xae\xcf\x43\xf5\xbe\x2c\x90\xf1\xe3\x41\x1c\xef\x4b\x4e\xd7\x90\xdc\xff\x3c\xcc\xbc\x6f\xf5\x47\x69\x1b\x0e\x69\x8f\xbc\xbb\x59\xdf\x67\x7c\x76\xf6\xf7\x76\xc6\xca\x58\x38\x6f\x8c\xca\x5c\x1d\x1f\x48\xe5\x27\x30\x17\x0f\x1a\xec\xa8\xd2\x0e\xe0\xf9\xde\x21\xe3\x11\x85\xd1\xb4\x45\xa3\xa0\x2a\x99\xd2\x6c\xe3\xd6\xd8\x9c\xf1\x42\xce\x86\x95\xd1\x31\x83\x86\x17\x72\xef\x89\x82\xbd\x47\x3b\xc7\xa6\xa7\xec\x08\x67\xdc\x76\x6a\x76\xf1\xf1\xe9\x22\x3c\x3e\xff\x9c\xf6\x71\xd3\x9c\x73\xec\xfa\xb0\x03\xfc\x1d\x14\x7f\x13\x1a\x14\x9a\x7d\x86\xfb\x7a\xe4\xc6\x3e\x6f\xca\xcb\x0e\xd1\xd1\xd6\xf1\x3a\x61\xfe\x86\xa7\xcc\x22\xcb\xaf\x6e\x9c\x09\x9d\xd0\xd7\x41\x10\x51\x11\x47\xaf\x15\xdc\x5c\x2d\x93\x74\xb3\xbd\x9a\x67\xce\xc4\xa1\x13\xb7\x45\x15\xbe\x0a\xe9\x87\x93\x2f\xbe\x76\x79\x17\xb5\x7f\x14\x0a\x97\x7b\xca\x93\x4a\x3b\x52\xfa\x4c\xf9\x81\x88\x43\xea\x69\x4f\x3a\x91\xa6\x8c\x82\x23\xbc\x48\x09\xa1\x3d\xce\x5c\xe5\x00\x78\xda\xd1\xdc\xd7\x3a\xf6\xc6\x0f\xbc\x04\xd2\xc0\x10\x44\x5e\x1c\x36\x3f\x60\xe5\xe1\x33\xd7\xe0\x53\x70\x18\xe3\x3e\xf5\x01\xf0\x82\x98\xe7\xba\x0e\x0d\x22\x2e\xb5\x8a\xfc\x10\xdc\x90\x2b\x3f\xd2\x5e\xe0\x72\xaa\xb9\x88\x39\xd7\x9a\x49\x07\x3c\xc1\x80\x29\xc6\x38\x84\x8e\x92\x8e\xa7\x15\xc7\xbb\x58\x5c\x85\x9e\x50\xae\x0e\xa8\x1f\x7b\x81\xe7\x71\xee\xfa\xd2\x8f\x22\x1d\x4b\x1e\x08\x70\x5d\xcf\x01\x26\xc1\x89\x94\x92\x9e\xe3\xba\xac\x73\x69\x20\x05\x7b\x32\x7b\x16\xf4\x0e\x8b\x26\xce\xc4\x8d\x27\x0e\xa3\x53\xc7\x61\x6e\xe7\x84\x23\x49\x45\xb6\x49\x1f\x13\x82\x57\x9b\xd3\x23\x99\xcd\x10\x2c\xaa\xf4\xd4\x7f\x5c\xbf\x1d\xe2\xea\x7b\xb3\x0d\xea\x11\x47\x7b\xaf\x7d\xb9\x4c\x7a\x51\xfb\x3f\x75\x7d\xab\x21\x60\xb3\xbd\x36\x43\xc8\x1c\xd0\x33\x49\xaa\xb0\xa2\x0f\x14\x3b\x95\x5d\xaa\xfa\x69\x65\x39\x34\x3c\x68\xb1\x39\x92\x18\xd6\x24\x02\xa4\xcd\xcb\xcd\x79\x2a\x17\x55\xa4\xa0\xde\x05\x9a\xaa\x53\x43\x80\x9f\xaa\x3b\x7a\x74\x97\x87\x89\x67\x7b\xcf\x44\x32\xcf\xf9\x6a\xef\xe1\xce\xd9\x2c\xfe\xf7\x9a\xc0\xcd\x4a\x25\xdd\xdc\x14\x7c\x98\x66\x59\xf7\xba\x20\x3e\xca\xd6\xf6\x5e\xce\xde\x53\x2c\x06\xb4\x77\x4f\x07\x1b\x9b\xbc\x6f\xf6\x4d\xba\xff\x74\x80\x00\x88\x8e\xea\xf6\x8c\x84\x7c\x42\xde\xad\xd6\xe6\xce\x16\x4a\xec\x7a\xbd\x95\xf2\x47\xd5\xb7\x91\x06\x2f\x20\xcf\xb1\x08\x8f\x45\xf9\xa4\x8f\xe7\x5f\x74\x6c\x70\x9e\x77\xaa\xeb\x0d\xa0\x7c\x00\x4a\x64\x8a\x4d\x8a\x97\x13\x30\x72\x65\xca\xcb\x62\x76\xdc\xf6\xb4\x5d\xb6\xaf\x65\x2e\xbf\x6f\xca\x84\xd5\xe5\xdd\x2b\x92\xe1\x3b\x47\xda\xf4\x8a\xe6\x5e\xd6\x84\xfc\xa5\x0c\xc2\xec\x74\x9c\x55\x35\x0f\xae\x5e\x9a\xad\xbd\xdf\xfd\x9b\xd9\x5e\xab\xef\xae\x3a\x37\xbe\x67\x7d\x8b\x2e\x1d\x02\xc5\x85\xf0\x54\xa0\x29\x47\xdb\x25\xe4\x2a\x94\x8a\x02\x0d\xb9\xa3\x19\x15\xbe\x17\x28\x41\xf1\x32\x6b\x14\xc4\xca\x97\x52\x50\xa5\x18\x77\x02\x08\xfd\xd8\x17\x57\xf4\x8a\xee\xd6\xfa\xe9\x94\xd6\x1a\x62\xeb\xda\x4f\x7a\x14\x9a\x0f\xd3\xab\x8e\x2c\x93\x7b\x01\x0b\xa9\x8b\xe7\xe1\xb1\x0f\x22\x74\x24\x73\x3d\x87\xfa\x9e\xe2\x3c\x70\xfd\x30\x94\x34\x60\x5e\xb7\xe0\xd3\x17\xb8\xfb\x64\x78\x7e\x0a\x1f\xec\xe1\xf3\x51\xdf\x16\x80\x15\xdf\xee\x86\xf3\x5b\x08\xca\xf8\x5f\x1f\x04\x9d\x48\xf6\xc9\x6c\xbc\x07\x3e\xe0\x6b\x6f\x3d\x0f\x2f\x20\xea\x58\x86\x4c\x4b\x26\x62\x2f\x88\x23\x0a\xda\x77\x54\xa4\x18\x8d\x84\xe0\xdc\x53\xae\x56\x52\x53\xe9\x87\xca\x8b\xbc\x90\x4b\xce\xe0\x08\x3b\x0c\x31\x42\x0a\x5b\xf3\x57\xb8\x3b\x03\xd0\xce\x23\xb2\xfb\xca\xe0\xaa\x7e\xdd\x74\x74\xa4\x6c\xde\x3d\x63\x8d\xe9\xd6\x75\xc1\x63\x6e\x1c\x51\x19\x0b\x37\x54\xd4\x8b\x84\xc2\x77\x44\x0b\xe5\x71\xc6\x41\xc4\xbe\xe3\x05\x31\x63\xd4\xf3\x3d\xea\x73\x29\x25\xd3\x5e\x10\x29\x0a\x3a\x0e\xe2\x28\xda\x29\x17\x57\xf1\xd1\xfe\x23\x72\x01\x46\xe9\x58\x1d\xdd\xa3\xb6\xcb\xcf\x24\x2b\x99\xf8\xa1\x29\x3d\xfa\xad\x66\xc1\xb7\x9a\x05\x5f\x49\xcd\x82\xe7\x76\x49\xda\x56\xc3\x3d\x83\xb8\x0b\xd8\x1e\x83\xe2\xd0\xde\xe8\x96\xda\x3d\xa1\xc8\x6e\x1f\xa4\x8f\xd7\x4b\xdf\x3e\x5f\xf9\xa7\x15\xe5\x2f\x97\x13\x99\x43\x66\xad\x14\x7b\xa6\xcb\x5a\x0a\x7a\x93\x56\x55\x14\xd0\x7a\xef\x72\x72\x1f\x9b\xba\xf5\x93\xba\x50\x6f\xf5\x32\xd9\xa1\xfd\x29\xd9\x6d\x72\xb2\xfb\x77\xe8\xe6\x25\x05\xc9\xb0\xd4\x43\xf9\x3e\x3f\x04\xa0\x3d\xd4\xaf\x2a\xaf\x96\x66\x69\xf9\x56\xed\x7a\xc6\xf6\x45\x23\x6d\x71\x5c\xac\xe2\x8e\xee\xc1\xa8\x67\xe2\xa3\xb6\x6d\x6f\xf1\xdc\xfd\x52\xb2\xbd\x8a\xa5\xff\x4e\x7f\x57\xfa\x4f\x3f\x07\xa8\x6f\xb2\x55\x65\xb6\x77\x57\x99\xf3\xdb\xea\xdf\xfb\x75\xea\x7b\x71\x9b\xd7\xf5\x87\x39\xbe\x33\xbe\x9b\x75\x3f\x39\x58\x73\x37\xbe\xd1\xbf\xe8\x9a\xa0\xd5\xb5\x91\xfa\x0d\x93\x7d\x60\x56\xaf\x9f\x3c\x05\xd6\xea\xb6\xe0\x8e\x59\x92\xe5\xe4\xfa\xed\xc4\x06\xdf\x5a\xde\xe0\x45\x79\x63\x32\xd1\x24\x2b\x4f\xae\x26\x83\xe0\x56\x34\xda\x83\xf6\x90\x73\x7a\x80\x3d\xc6\x3a\xad\xb8\xd5\xfb\x7e\xff\xbb\x2e\x3b\x0e\x73\xf7\x95\x97\x07\x38\x3f\x8f\xcf\x1a\x7e\xc2\x49\x50\x3c\x08\xc1\x92\x57\xbd\x14\xc0\x97\x75\x9e\x82\x7d\x5c\x81\xb6\xaf\xde\xac\xdf\xca\x79\x1f\xd2\x4f\x81\xb7\xeb\xa7\xfc\x15\xee\x76\xb1\x3e\x84\x60\x54\x06\x5f\xe0\xee\xe5\xba\xaa\x35\xff\x1d\x7a\xf5\x5c\x4a\x94\xd7\x4a\x58\x6b\x5f\x64\x08\x99\x25\x61\xbf\xc0\xdd\x29\xc0\x1e\x0a\x6b\xad\xb2\x1f\x59\x05\xb7\x3c\x86\xa8\xae\x94\xf4\x52\xa9\x52\x45\xa7\x10\xea\x50\x6b\x55\x37\x72\x93\xce\x8d\xc8\x62\x2f\x07\xe0\x1c\xe9\x7e\x10\x36\x3c\x3f\x80\xfa\xb0\x75\x67\xd5\xef\xf1\x68\xae\x77\xcd\xf6\x40\xe1\x94\x15\xff\x36\x3a\xff\x0c\xe2\xc1\x0b\x3e\x8c\xf3\xed\x9f\x50\xec\x9c\xeb\x35\xf8\xc1\x36\x55\x11\x8e\xeb\xb7\xa7\xf3\x79\x75\xcb\xba\xd5\xc7\x07\xf0\x1f\x70\x73\x72\x92\xe4\xed\x81\x37\xa6\xdb\x18\xdf\xee\xec\xb3\x80\x87\x01\x07\x3f\xa0\xcc\xf3\x34\x3a\xd4\xd4\x97\x92\x52\x27\x0e\x43\xe6\x05\x52\xc4\x4c\x32\xe1\x69\x07\x98\x08\x39\xa3\x1e\x78\xe8\x88\xc7\xd0\xbc\x7c\xc7\xfe\xdf\xde\x3b\x20\x6a\x20\x4a\x79\x5b\x67\xc5\x79\x74\xe5\xa4\xe0\x37\x4d\xb5\xbf\xeb\xb7\x56\x61\xe6\x50\x6c\x56\x65\xa8\x17\x48\xf7\x2d\x1d\x3b\xaa\xe9\xfa\xed\x63\xb7\x84\x77\xd5\xfb\xd7\x7b\x97\x52\xbf\x9c\xfd\xc8\x7a\xfa\xd9\xec\xc8\x2a\xbb\x86\x4e\xfd\x66\xe3\x6a\x15\x49\xd1\xcc\x34\x39\x7d\xeb\xfd\x00\xf6\x96\x58\x3f\x0d\xca\xdf\x2e\x0a\x77\x56\x81\x4d\xcc\xf6\x95\xd5\x33\x24\x31\xe3\x62\x6f\xaa\x61\xb8\xff\x67\x00\x23\xfc\x77\xa2\x51\xa3\x00\x00")
 
 func thorYamlBytes() ([]byte, error) {
 	return bindataRead(
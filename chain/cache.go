@@ -6,22 +6,35 @@
 package chain
 
 import (
+	"sync/atomic"
+
 	lru "github.com/hashicorp/golang-lru"
 )
 
+// CacheStats presents hit/miss counters of a cache.
+type CacheStats struct {
+	Hit  uint64
+	Miss uint64
+}
+
 type cache struct {
+	// keep 64-bit fields at the beginning for atomic operations
+	hit  uint64
+	miss uint64
 	*lru.ARCCache
 }
 
 func newCache(maxSize int) *cache {
 	c, _ := lru.NewARC(maxSize)
-	return &cache{c}
+	return &cache{ARCCache: c}
 }
 
 func (c *cache) GetOrLoad(key interface{}, load func() (interface{}, error)) (interface{}, error) {
 	if value, ok := c.Get(key); ok {
+		atomic.AddUint64(&c.hit, 1)
 		return value, nil
 	}
+	atomic.AddUint64(&c.miss, 1)
 	value, err := load()
 	if err != nil {
 		return nil, err
@@ -29,3 +42,11 @@ func (c *cache) GetOrLoad(key interface{}, load func() (interface{}, error)) (in
 	c.Add(key, value)
 	return value, nil
 }
+
+// Stats returns hit/miss counters of GetOrLoad.
+func (c *cache) Stats() CacheStats {
+	return CacheStats{
+		Hit:  atomic.LoadUint64(&c.hit),
+		Miss: atomic.LoadUint64(&c.miss),
+	}
+}
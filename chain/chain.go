@@ -8,6 +8,8 @@ package chain
 import (
 	"encoding/binary"
 	"sort"
+	"sync"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/pkg/errors"
@@ -43,6 +45,14 @@ type Chain struct {
 }
 
 func newChain(repo *Repository, headID thor.Bytes32) *Chain {
+	return newChainWith(repo, headID, func() (*BlockSummary, error) {
+		return repo.GetBlockSummary(headID)
+	})
+}
+
+// newChainWith create a chain, and the head summary is loaded by getSummary on first access.
+// Each chain has its own index trie, since the trie is not safe for concurrent access.
+func newChainWith(repo *Repository, headID thor.Bytes32, getSummary func() (*BlockSummary, error)) *Chain {
 	var (
 		indexTrie *muxdb.Trie
 		initErr   error
@@ -54,7 +64,7 @@ func newChain(repo *Repository, headID thor.Bytes32) *Chain {
 		func() (*muxdb.Trie, error) {
 			if indexTrie == nil && initErr == nil {
 				var summary *BlockSummary
-				if summary, initErr = repo.GetBlockSummary(headID); initErr == nil {
+				if summary, initErr = getSummary(); initErr == nil {
 					indexTrie = repo.db.NewTrie(IndexTrieName, summary.IndexRoot)
 				}
 			}
@@ -63,22 +73,40 @@ func newChain(repo *Repository, headID thor.Bytes32) *Chain {
 	}
 }
 
+// getFromTrie gets value from the index trie.
+func (c *Chain) getFromTrie(key []byte) ([]byte, error) {
+	// the index trie is read through the db, so check here if the repository is closed
+	if c.repo.data.isClosed() {
+		return nil, errClosed
+	}
+
+	trie, err := c.lazyInit()
+	if err != nil {
+		return nil, err
+	}
+	return trie.Get(key)
+}
+
 // HeadID returns the head block id.
 func (c *Chain) HeadID() thor.Bytes32 {
 	return c.headID
 }
 
+// HeadNumber returns the head block number.
+func (c *Chain) HeadNumber() uint32 {
+	return block.Number(c.headID)
+}
+
 // GetBlockID returns block id by given block number.
+// Error not found is returned if num exceeds the head block number.
 func (c *Chain) GetBlockID(num uint32) (thor.Bytes32, error) {
-	trie, err := c.lazyInit()
-	if err != nil {
-		return thor.Bytes32{}, err
+	if num > c.HeadNumber() {
+		return thor.Bytes32{}, errNotFound
 	}
-
 	var key [4]byte
 	binary.BigEndian.PutUint32(key[:], num)
 
-	data, err := trie.Get(key[:])
+	data, err := c.getFromTrie(key[:])
 	if err != nil {
 		return thor.Bytes32{}, err
 	}
@@ -90,12 +118,7 @@ func (c *Chain) GetBlockID(num uint32) (thor.Bytes32, error) {
 
 // GetTransactionMeta returns tx meta by given tx id.
 func (c *Chain) GetTransactionMeta(id thor.Bytes32) (*TxMeta, error) {
-	trie, err := c.lazyInit()
-	if err != nil {
-		return nil, err
-	}
-
-	enc, err := trie.Get(id[:])
+	enc, err := c.getFromTrie(id[:])
 	if err != nil {
 		return nil, err
 	}
@@ -176,29 +199,58 @@ func (c *Chain) HasBlock(id thor.Bytes32) (bool, error) {
 	return id == foundID, nil
 }
 
-// Exclude returns ids of blocks belongs to this chain, but not belongs to other.
-//
-// The returned ids are in ascending order.
-func (c *Chain) Exclude(other *Chain) ([]thor.Bytes32, error) {
-	var ids []thor.Bytes32
+// Distance returns the number of blocks from the given ancestor to the head.
+// Error not found is returned if the ancestor is not on this chain.
+func (c *Chain) Distance(ancestorID thor.Bytes32) (uint32, error) {
+	has, err := c.HasBlock(ancestorID)
+	if err != nil {
+		return 0, err
+	}
+	if !has {
+		return 0, errNotFound
+	}
+	return c.HeadNumber() - block.Number(ancestorID), nil
+}
+
+// CommonAncestor returns id of the highest block that belongs to both this chain and other.
+func (c *Chain) CommonAncestor(other *Chain) (thor.Bytes32, error) {
+	num := c.HeadNumber()
+	if n := other.HeadNumber(); n < num {
+		num = n
+	}
 	// use int64 to prevent infinite loop
-	for i := int64(block.Number(c.headID)); i >= 0; i-- {
+	for i := int64(num); i >= 0; i-- {
 		id, err := c.GetBlockID(uint32(i))
 		if err != nil {
-			return nil, err
+			return thor.Bytes32{}, err
 		}
 		has, err := other.HasBlock(id)
 		if err != nil {
-			return nil, err
+			return thor.Bytes32{}, err
 		}
 		if has {
-			break
+			return id, nil
 		}
-		ids = append(ids, id)
 	}
-	// reverse
-	for i, j := 0, len(ids)-1; i < j; i, j = i+1, j-1 {
-		ids[i], ids[j] = ids[j], ids[i]
+	return thor.Bytes32{}, errNotFound
+}
+
+// Exclude returns ids of blocks belongs to this chain, but not belongs to other.
+//
+// The returned ids are in ascending order.
+func (c *Chain) Exclude(other *Chain) ([]thor.Bytes32, error) {
+	ancestor, err := c.CommonAncestor(other)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []thor.Bytes32
+	for i := block.Number(ancestor) + 1; i <= c.HeadNumber(); i++ {
+		id, err := c.GetBlockID(i)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
 	}
 	return ids, nil
 }
@@ -249,8 +301,12 @@ func (c *Chain) FindBlockHeaderByTimestamp(ts uint64, flag int) (header *block.H
 }
 
 // NewBestChain create a chain with best block as head.
+// The best block summary is memoized along with the best block, so it's not loaded again.
 func (r *Repository) NewBestChain() *Chain {
-	return newChain(r, r.BestBlock().Header().ID())
+	summary := r.BestBlockSummary()
+	return newChainWith(r, summary.Header.ID(), func() (*BlockSummary, error) {
+		return summary, nil
+	})
 }
 
 // NewChain create a chain with head block specified by headID.
@@ -258,6 +314,103 @@ func (r *Repository) NewChain(headID thor.Bytes32) *Chain {
 	return newChain(r, headID)
 }
 
+// GetTransactionMeta returns the meta of the tx on the canonical chain.
+// The tx index is stored per branch, so txs only in side chains are not found.
+func (r *Repository) GetTransactionMeta(txID thor.Bytes32) (*TxMeta, error) {
+	return r.NewBestChain().GetTransactionMeta(txID)
+}
+
+// GetReceiptByTxID returns the receipt of the tx on the canonical chain, along with id of the block it's in.
+// Since the tx index is stored per branch, txs only in side chains are not found.
+func (r *Repository) GetReceiptByTxID(txID thor.Bytes32) (*tx.Receipt, thor.Bytes32, error) {
+	meta, err := r.GetTransactionMeta(txID)
+	if err != nil {
+		return nil, thor.Bytes32{}, err
+	}
+
+	key := makeTxKey(meta.BlockID, receiptInfix)
+	key.SetIndex(meta.Index)
+	receipt, err := r.getReceipt(key)
+	if err != nil {
+		return nil, thor.Bytes32{}, err
+	}
+	return receipt, meta.BlockID, nil
+}
+
+// CanonicalIDAt returns id of the block at the given height on the canonical chain.
+// found is false if the height is above the best block.
+func (r *Repository) CanonicalIDAt(num uint32) (id thor.Bytes32, found bool, err error) {
+	id, err = r.NewBestChain().GetBlockID(num)
+	if err != nil {
+		if r.IsNotFound(err) {
+			return thor.Bytes32{}, false, nil
+		}
+		return thor.Bytes32{}, false, err
+	}
+	return id, true, nil
+}
+
+// GetBlockByNumber returns the block at the given height on the canonical chain.
+// Error not found is returned if num exceeds the best block number.
+func (r *Repository) GetBlockByNumber(num uint32) (*block.Block, error) {
+	return r.NewBestChain().GetBlock(num)
+}
+
+// GetFinalizedBlock returns the block only if it's the finalized block or its ancestor.
+// Error not finalized is returned otherwise, and error not found if the finalized block is unknown.
+func (r *Repository) GetFinalizedBlock(id thor.Bytes32, finalizedID thor.Bytes32) (*block.Block, error) {
+	if _, err := r.GetBlockSummary(finalizedID); err != nil {
+		return nil, err
+	}
+	has, err := r.NewChain(finalizedID).HasBlock(id)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errNotFinalized
+	}
+	return r.GetBlock(id)
+}
+
+// ConflictsWith checks whether each of the candidate blocks conflicts with the ref block.
+// Two blocks conflict if neither of them is an ancestor of the other.
+// Candidates not found in the repository yield false, while error not found is returned if ref is unknown.
+func (r *Repository) ConflictsWith(ref thor.Bytes32, candidates []thor.Bytes32) ([]bool, error) {
+	if _, err := r.GetBlockSummary(ref); err != nil {
+		return nil, err
+	}
+
+	var (
+		refChain = r.NewChain(ref)
+		refNum   = block.Number(ref)
+		results  = make([]bool, len(candidates))
+	)
+	for i, id := range candidates {
+		if _, err := r.GetBlockSummary(id); err != nil {
+			if r.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		var (
+			has bool
+			err error
+		)
+		if block.Number(id) <= refNum {
+			has, err = refChain.HasBlock(id)
+		} else {
+			// the candidate is higher, so check if ref is its ancestor
+			has, err = r.NewChain(id).HasBlock(ref)
+		}
+		if err != nil {
+			return nil, err
+		}
+		results[i] = !has
+	}
+	return results, nil
+}
+
 func (r *Repository) indexBlock(parentIndexRoot thor.Bytes32, block *block.Block, receipts tx.Receipts) (thor.Bytes32, error) {
 	txs := block.Transactions()
 	if len(txs) != len(receipts) {
@@ -273,16 +426,55 @@ func (r *Repository) indexBlock(parentIndexRoot thor.Bytes32, block *block.Block
 	}
 
 	// map tx id to tx meta
-	for i, tx := range block.Transactions() {
-		enc, err := rlp.EncodeToBytes(&TxMeta{
+	var (
+		keys = make([][]byte, len(txs))
+		vals = make([][]byte, len(txs))
+		errs = make([]error, len(txs))
+	)
+	encode := func(i int) {
+		// tx id involves signer recovery, which is the most expensive part
+		keys[i] = txs[i].ID().Bytes()
+		vals[i], errs[i] = rlp.EncodeToBytes(&TxMeta{
 			BlockID:  id,
 			Index:    uint64(i),
 			Reverted: receipts[i].Reverted,
 		})
-		if err != nil {
-			return thor.Bytes32{}, err
+	}
+
+	if workers := r.indexWorkers; workers > 1 && len(txs) > 1 {
+		if workers > len(txs) {
+			workers = len(txs)
+		}
+		var (
+			wg   sync.WaitGroup
+			next = int32(-1)
+		)
+		wg.Add(workers)
+		for w := 0; w < workers; w++ {
+			go func() {
+				defer wg.Done()
+				for {
+					i := int(atomic.AddInt32(&next, 1))
+					if i >= len(txs) {
+						return
+					}
+					encode(i)
+				}
+			}()
+		}
+		wg.Wait()
+	} else {
+		for i := range txs {
+			encode(i)
+		}
+	}
+
+	// update the trie in tx order, to keep it identical to serial indexing
+	for i := range txs {
+		if errs[i] != nil {
+			return thor.Bytes32{}, errs[i]
 		}
-		if err := trie.Update(tx.ID().Bytes(), enc); err != nil {
+		if err := trie.Update(keys[i], vals[i]); err != nil {
 			return thor.Bytes32{}, err
 		}
 	}
@@ -45,6 +45,7 @@ func TestChain(t *testing.T) {
 	c := repo.NewChain(b3.Header().ID())
 
 	assert.Equal(t, b3.Header().ID(), c.HeadID())
+	assert.Equal(t, uint32(3), c.HeadNumber())
 	assert.Equal(t, M(b3.Header().ID(), nil), M(c.GetBlockID(3)))
 	assert.Equal(t, M(b3.Header(), nil), M(c.GetBlockHeader(3)))
 	assert.Equal(t, M(block.Compose(b3.Header(), b3.Transactions()), nil), M(c.GetBlock(3)))
@@ -52,6 +53,14 @@ func TestChain(t *testing.T) {
 	_, err := c.GetBlockID(4)
 	assert.True(t, c.IsNotFound(err))
 
+	// b4 exists, but beyond the head of chain
+	b4 := newBlock(b3, 40)
+	repo.AddBlock(b4, nil)
+	c2 := repo.NewChain(b2.Header().ID())
+	assert.Equal(t, M(b2.Header().ID(), nil), M(c2.GetBlockID(2)))
+	_, err = c2.GetBlockID(3)
+	assert.True(t, c2.IsNotFound(err))
+
 	assert.Equal(t, M(tx1Meta, nil), M(c.GetTransactionMeta(tx1.ID())))
 	assert.Equal(t, M(tx1, tx1Meta, nil), M(c.GetTransaction(tx1.ID())))
 	assert.Equal(t, M(tx1Receipt, nil), M(c.GetTransactionReceipt(tx1.ID())))
@@ -68,4 +77,200 @@ func TestChain(t *testing.T) {
 
 	assert.Equal(t, M([]thor.Bytes32{b3.Header().ID()}, nil), M(c1.Exclude(c2)))
 	assert.Equal(t, M([]thor.Bytes32{b3x.Header().ID()}, nil), M(c2.Exclude(c1)))
+	assert.Equal(t, M(b2.Header().ID(), nil), M(c1.CommonAncestor(c2)))
+	assert.Equal(t, M(b2.Header().ID(), nil), M(c2.CommonAncestor(c1)))
+	assert.Equal(t, M(b2.Header().ID(), nil), M(c1.CommonAncestor(repo.NewChain(b2.Header().ID()))))
+	assert.Equal(t, M(b3.Header().ID(), nil), M(c1.CommonAncestor(c1)))
+
+	tx2 := newTx()
+	b4x := newBlock(b3x, 40, tx2)
+	repo.AddBlock(b4x, tx.Receipts{&tx.Receipt{}})
+
+	repo.SetBestBlockID(b4.Header().ID())
+	assert.Equal(t, M(tx1Meta, nil), M(repo.GetTransactionMeta(tx1.ID())))
+	_, err = repo.GetTransactionMeta(tx2.ID())
+	assert.True(t, repo.IsNotFound(err))
+
+	unknown := newBlock(b3x, 40)
+
+	assert.Equal(t, M([]bool{false, false, true, false, false, false}, nil),
+		M(repo.ConflictsWith(b3.Header().ID(), []thor.Bytes32{
+			b1.Header().ID(),
+			b3.Header().ID(),
+			b3x.Header().ID(),
+			b4.Header().ID(),
+			unknown.Header().ID(),
+			repo.GenesisBlock().Header().ID(),
+		})))
+	assert.Equal(t, M([]bool{true}, nil), M(repo.ConflictsWith(b3x.Header().ID(), []thor.Bytes32{b4.Header().ID()})))
+
+	_, err = repo.ConflictsWith(unknown.Header().ID(), []thor.Bytes32{repo.GenesisBlock().Header().ID()})
+	assert.True(t, repo.IsNotFound(err))
+}
+
+func TestCommonAncestor(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+
+	// deep fork sharing only genesis
+	a, b := b0, b0
+	for i := 1; i <= 5; i++ {
+		a = newBlock(a, uint64(i*10))
+		repo.AddBlock(a, nil)
+		b = newBlock(b, uint64(i*10))
+		repo.AddBlock(b, nil)
+	}
+	ca, cb := repo.NewChain(a.Header().ID()), repo.NewChain(b.Header().ID())
+	assert.Equal(t, M(b0.Header().ID(), nil), M(ca.CommonAncestor(cb)))
+	assert.Equal(t, M(b0.Header().ID(), nil), M(cb.CommonAncestor(ca)))
+
+	// shallow fork with different lengths
+	a1 := newBlock(a, 60)
+	repo.AddBlock(a1, nil)
+	a2 := newBlock(a1, 70)
+	repo.AddBlock(a2, nil)
+	ax := newBlock(a, 60)
+	repo.AddBlock(ax, nil)
+	c1, c2 := repo.NewChain(a2.Header().ID()), repo.NewChain(ax.Header().ID())
+	assert.Equal(t, M(a.Header().ID(), nil), M(c1.CommonAncestor(c2)))
+	assert.Equal(t, M(a.Header().ID(), nil), M(c2.CommonAncestor(c1)))
+	assert.Equal(t, M([]thor.Bytes32{a1.Header().ID(), a2.Header().ID()}, nil), M(c1.Exclude(c2)))
+}
+
+func TestCanonicalIDAt(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+
+	ids := []thor.Bytes32{b0.Header().ID()}
+	parent := b0
+	for i := 1; i <= 3; i++ {
+		b := newBlock(parent, uint64(i*10))
+		repo.AddBlock(b, nil)
+		ids = append(ids, b.Header().ID())
+		parent = b
+	}
+	// side block should not be seen
+	repo.AddBlock(newBlock(b0, 10), nil)
+	repo.SetBestBlockID(parent.Header().ID())
+
+	for i, id := range ids {
+		assert.Equal(t, M(id, true, nil), M(repo.CanonicalIDAt(uint32(i))))
+	}
+	assert.Equal(t, M(thor.Bytes32{}, false, nil), M(repo.CanonicalIDAt(uint32(len(ids)))))
+}
+
+func TestDistance(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+	b1 := newBlock(b0, 10)
+	b2 := newBlock(b1, 20)
+	b1x := newBlock(b0, 10)
+	repo.AddBlock(b1, nil)
+	repo.AddBlock(b2, nil)
+	repo.AddBlock(b1x, nil)
+
+	c := repo.NewChain(b2.Header().ID())
+	assert.Equal(t, M(uint32(2), nil), M(c.Distance(b0.Header().ID())))
+	assert.Equal(t, M(uint32(1), nil), M(c.Distance(b1.Header().ID())))
+	assert.Equal(t, M(uint32(0), nil), M(c.Distance(b2.Header().ID())))
+
+	_, err := c.Distance(b1x.Header().ID())
+	assert.True(t, c.IsNotFound(err))
+}
+
+func TestGetFinalizedBlock(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+	b1 := newBlock(b0, 10)
+	b2 := newBlock(b1, 20)
+	b1x := newBlock(b0, 10)
+	repo.AddBlock(b1, nil)
+	repo.AddBlock(b2, nil)
+	repo.AddBlock(b1x, nil)
+	repo.SetBestBlockID(b2.Header().ID())
+
+	finalized := b1.Header().ID()
+	for _, b := range []*block.Block{b0, b1} {
+		got, err := repo.GetFinalizedBlock(b.Header().ID(), finalized)
+		assert.Nil(t, err)
+		assert.Equal(t, b.Header().ID(), got.Header().ID())
+	}
+
+	// the best block is not finalized yet
+	_, err := repo.GetFinalizedBlock(b2.Header().ID(), finalized)
+	assert.True(t, chain.IsNotFinalized(err))
+	// conflicts with the finalized block
+	_, err = repo.GetFinalizedBlock(b1x.Header().ID(), finalized)
+	assert.True(t, chain.IsNotFinalized(err))
+
+	// unknown finalized block
+	_, err = repo.GetFinalizedBlock(b0.Header().ID(), newBlock(b2, 30).Header().ID())
+	assert.True(t, repo.IsNotFound(err))
+	assert.False(t, chain.IsNotFinalized(err))
+}
+
+func BenchmarkBestChainHasBlock(b *testing.B) {
+	repo := newTestRepo()
+	parent := repo.GenesisBlock()
+	for i := 1; i <= 100; i++ {
+		blk := newBlock(parent, uint64(i*10))
+		repo.AddBlock(blk, nil)
+		parent = blk
+	}
+	repo.SetBestBlockID(parent.Header().ID())
+	id := parent.Header().ID()
+
+	bench := func(newChain func() *chain.Chain) func(*testing.B) {
+		return func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					newChain().HasBlock(id)
+				}
+			})
+		}
+	}
+	b.Run("memoized", bench(repo.NewBestChain))
+	b.Run("unmemoized", bench(func() *chain.Chain { return repo.NewChain(id) }))
+}
+
+func TestGetReceiptByTxID(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+
+	tx1, tx2 := newTx(), newTx()
+	b1 := newBlock(b0, 10, tx1)
+	b1x := newBlock(b0, 10, tx2)
+	repo.AddBlock(b1, tx.Receipts{&tx.Receipt{GasUsed: 1}})
+	repo.AddBlock(b1x, tx.Receipts{&tx.Receipt{GasUsed: 2}})
+	repo.SetBestBlockID(b1.Header().ID())
+
+	receipt, blockID, err := repo.GetReceiptByTxID(tx1.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, b1.Header().ID(), blockID)
+	assert.Equal(t, uint64(1), receipt.GasUsed)
+
+	// tx only in the side chain
+	_, _, err = repo.GetReceiptByTxID(tx2.ID())
+	assert.True(t, repo.IsNotFound(err))
+}
+
+func TestGetBlockByNumber(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+	b1 := newBlock(b0, 10)
+	b2 := newBlock(b1, 20)
+	b1x := newBlock(b0, 10)
+	repo.AddBlock(b1, nil)
+	repo.AddBlock(b2, nil)
+	repo.AddBlock(b1x, nil)
+	repo.SetBestBlockID(b2.Header().ID())
+
+	for i, b := range []*block.Block{b0, b1, b2} {
+		got, err := repo.GetBlockByNumber(uint32(i))
+		assert.Nil(t, err)
+		assert.Equal(t, b.Header().ID(), got.Header().ID())
+	}
+
+	_, err := repo.GetBlockByNumber(3)
+	assert.True(t, repo.IsNotFound(err))
 }
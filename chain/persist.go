@@ -28,6 +28,16 @@ type BlockSummary struct {
 	Size      uint64
 }
 
+// Number returns the block number.
+func (s *BlockSummary) Number() uint32 {
+	return s.Header.Number()
+}
+
+// TotalScore returns the total score of the block.
+func (s *BlockSummary) TotalScore() uint64 {
+	return s.Header.TotalScore()
+}
+
 // the key for tx/receipt.
 // it consists of: ( block id | infix | index )
 type txKey [32 + 1 + 8]byte
@@ -8,6 +8,7 @@ package chain
 import (
 	"sync/atomic"
 
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/co"
@@ -23,16 +24,69 @@ const (
 )
 
 var (
-	errNotFound    = errors.New("not found")
-	bestBlockIDKey = []byte("best-block-id")
+	errNotFound      = errors.New("not found")
+	errClosed        = errors.New("repository closed")
+	errBlockTooLarge = errors.New("block too large")
+	errNotFinalized  = errors.New("block not finalized")
+	bestBlockIDKey   = []byte("best-block-id")
 )
 
+// bestBlock holds the best block along with its summary.
+type bestBlock struct {
+	block   *block.Block
+	summary *BlockSummary
+}
+
+// RepositoryStats presents statistics of repository.
+type RepositoryStats struct {
+	Summaries CacheStats
+	Txs       CacheStats
+	Receipts  CacheStats
+
+	// StoreReads counts reads on the data store.
+	StoreReads uint64
+}
+
+// dataStore wraps kv.Store to count reads, and to reject accesses once closed.
+type dataStore struct {
+	reads  uint64
+	closed int32
+	kv.Store
+}
+
+func (s *dataStore) isClosed() bool {
+	return atomic.LoadInt32(&s.closed) != 0
+}
+
+func (s *dataStore) Get(key []byte) ([]byte, error) {
+	if s.isClosed() {
+		return nil, errClosed
+	}
+	atomic.AddUint64(&s.reads, 1)
+	return s.Store.Get(key)
+}
+
+func (s *dataStore) Has(key []byte) (bool, error) {
+	if s.isClosed() {
+		return false, errClosed
+	}
+	atomic.AddUint64(&s.reads, 1)
+	return s.Store.Has(key)
+}
+
+func (s *dataStore) Batch(fn func(kv.PutFlusher) error) error {
+	if s.isClosed() {
+		return errClosed
+	}
+	return s.Store.Batch(fn)
+}
+
 // Repository stores block headers, txs and receipts.
 //
 // It's thread-safe.
 type Repository struct {
 	db    *muxdb.MuxDB
-	data  kv.Store
+	data  *dataStore
 	props kv.Store
 
 	genesis *block.Block
@@ -40,6 +94,12 @@ type Repository struct {
 	tag     byte
 	tick    co.Signal
 
+	feedScope event.SubscriptionScope
+
+	maxBlockSize uint64
+	indexWorkers int
+	isNotFound   func(error) bool
+
 	caches struct {
 		summaries *cache
 		txs       *cache
@@ -47,38 +107,117 @@ type Repository struct {
 	}
 }
 
-// NewRepository create an instance of repository.
+// RepositoryOptions options for repository.
+// Zero values fall back to the defaults.
+type RepositoryOptions struct {
+	SummaryCacheSize int
+	TxCacheSize      int
+	ReceiptCacheSize int
+
+	// MaxBlockSize is the max size in bytes of blocks accepted by AddBlock.
+	MaxBlockSize uint64
+
+	// IndexWorkers is the number of goroutines used to prepare tx index entries of a block.
+	// Zero or one means indexing serially.
+	IndexWorkers int
+
+	// IsNotFound additionally recognizes not found errors, e.g. those wrapped by a storage backend.
+	// It's composed with the default check.
+	IsNotFound func(error) bool
+}
+
+// DefaultRepositoryOptions the default options for repository.
+var DefaultRepositoryOptions = RepositoryOptions{
+	SummaryCacheSize: 512,
+	TxCacheSize:      2048,
+	ReceiptCacheSize: 2048,
+	MaxBlockSize:     10 * 1024 * 1024, // consistent with the max p2p message size
+}
+
+// NewRepository create an instance of repository with default options.
 func NewRepository(db *muxdb.MuxDB, genesis *block.Block) (*Repository, error) {
+	return NewRepositoryWithOptions(db, genesis, DefaultRepositoryOptions)
+}
+
+// NewRepositoryWithOptions create an instance of repository with the given options.
+func NewRepositoryWithOptions(db *muxdb.MuxDB, genesis *block.Block, options RepositoryOptions) (*Repository, error) {
 	if genesis.Header().Number() != 0 {
 		return nil, errors.New("genesis number != 0")
 	}
 	if len(genesis.Transactions()) != 0 {
 		return nil, errors.New("genesis block should not have transactions")
 	}
+	return newRepository(db, genesis, nil, options)
+}
+
+// NewRepositoryFromCheckpoint create an instance of repository, which treats the checkpoint block as genesis.
+// It's intended for tools forking a chain at a checkpoint, and has limitations:
+//   - blocks, txs and receipts before the checkpoint are not available
+//   - the chain tag is the last byte of the checkpoint id, so txs of the original chain are not accepted
+//   - the state of the checkpoint should be provided in db by the caller
+func NewRepositoryFromCheckpoint(db *muxdb.MuxDB, checkpoint *block.Block, receipts tx.Receipts) (*Repository, error) {
+	return NewRepositoryFromCheckpointWithOptions(db, checkpoint, receipts, DefaultRepositoryOptions)
+}
+
+// NewRepositoryFromCheckpointWithOptions is the same as NewRepositoryFromCheckpoint, but with the given options.
+func NewRepositoryFromCheckpointWithOptions(db *muxdb.MuxDB, checkpoint *block.Block, receipts tx.Receipts, options RepositoryOptions) (*Repository, error) {
+	return newRepository(db, checkpoint, receipts, options)
+}
+
+func newRepository(db *muxdb.MuxDB, genesis *block.Block, genesisReceipts tx.Receipts, options RepositoryOptions) (*Repository, error) {
+	cacheSize := func(size, def int, name string) (int, error) {
+		switch {
+		case size < 0:
+			return 0, errors.Errorf("invalid %v cache size %v", name, size)
+		case size == 0:
+			return def, nil
+		}
+		return size, nil
+	}
+	var err error
+	if options.SummaryCacheSize, err = cacheSize(options.SummaryCacheSize, DefaultRepositoryOptions.SummaryCacheSize, "summary"); err != nil {
+		return nil, err
+	}
+	if options.TxCacheSize, err = cacheSize(options.TxCacheSize, DefaultRepositoryOptions.TxCacheSize, "tx"); err != nil {
+		return nil, err
+	}
+	if options.ReceiptCacheSize, err = cacheSize(options.ReceiptCacheSize, DefaultRepositoryOptions.ReceiptCacheSize, "receipt"); err != nil {
+		return nil, err
+	}
+
+	if options.IndexWorkers < 0 {
+		return nil, errors.Errorf("invalid index workers %v", options.IndexWorkers)
+	}
+	if options.MaxBlockSize == 0 {
+		options.MaxBlockSize = DefaultRepositoryOptions.MaxBlockSize
+	}
 
 	genesisID := genesis.Header().ID()
 	repo := &Repository{
-		db:      db,
-		data:    db.NewStore(dataStoreName),
-		props:   db.NewStore(propStoreName),
-		genesis: genesis,
-		tag:     genesisID[31],
+		db:           db,
+		data:         &dataStore{Store: db.NewStore(dataStoreName)},
+		props:        db.NewStore(propStoreName),
+		genesis:      genesis,
+		tag:          genesisID[31],
+		maxBlockSize: options.MaxBlockSize,
+		indexWorkers: options.IndexWorkers,
+		isNotFound:   options.IsNotFound,
 	}
 
-	repo.caches.summaries = newCache(512)
-	repo.caches.txs = newCache(2048)
-	repo.caches.receipts = newCache(2048)
+	repo.caches.summaries = newCache(options.SummaryCacheSize)
+	repo.caches.txs = newCache(options.TxCacheSize)
+	repo.caches.receipts = newCache(options.ReceiptCacheSize)
 
 	if val, err := repo.props.Get(bestBlockIDKey); err != nil {
 		if !repo.props.IsNotFound(err) {
 			return nil, err
 		}
 
-		indexRoot, err := repo.indexBlock(thor.Bytes32{}, genesis, nil)
+		indexRoot, err := repo.indexBlock(thor.Bytes32{}, genesis, genesisReceipts)
 		if err != nil {
 			return nil, err
 		}
-		if err := repo.saveBlock(genesis, nil, indexRoot); err != nil {
+		if err := repo.saveBlock(genesis, genesisReceipts, indexRoot); err != nil {
 			return nil, err
 		}
 		if err := repo.setBestBlock(genesis); err != nil {
@@ -86,7 +225,7 @@ func NewRepository(db *muxdb.MuxDB, genesis *block.Block) (*Repository, error) {
 		}
 	} else {
 		bestID := thor.BytesToBytes32(val)
-		existingGenesisID, err := repo.NewChain(bestID).GetBlockID(0)
+		existingGenesisID, err := repo.NewChain(bestID).GetBlockID(genesis.Header().Number())
 		if err != nil {
 			return nil, errors.Wrap(err, "get existing genesis id")
 		}
@@ -98,7 +237,11 @@ func NewRepository(db *muxdb.MuxDB, genesis *block.Block) (*Repository, error) {
 		if err != nil {
 			return nil, errors.Wrap(err, "get best block")
 		}
-		repo.best.Store(b)
+		summary, err := repo.GetBlockSummary(bestID)
+		if err != nil {
+			return nil, errors.Wrap(err, "get best block summary")
+		}
+		repo.best.Store(&bestBlock{b, summary})
 	}
 
 	return repo, nil
@@ -116,7 +259,13 @@ func (r *Repository) GenesisBlock() *block.Block {
 
 // BestBlock returns the best block, which is the newest block of canonical chain.
 func (r *Repository) BestBlock() *block.Block {
-	return r.best.Load().(*block.Block)
+	return r.best.Load().(*bestBlock).block
+}
+
+// BestBlockSummary returns the summary of the best block.
+// It's cheaper than BestBlock when only header or size is needed.
+func (r *Repository) BestBlockSummary() *BlockSummary {
+	return r.best.Load().(*bestBlock).summary
 }
 
 // SetBestBlockID set the given block id as best block id.
@@ -134,66 +283,146 @@ func (r *Repository) SetBestBlockID(id thor.Bytes32) (err error) {
 }
 
 func (r *Repository) setBestBlock(b *block.Block) error {
+	if r.data.isClosed() {
+		return errClosed
+	}
+	summary, err := r.GetBlockSummary(b.Header().ID())
+	if err != nil {
+		return err
+	}
 	if err := r.props.Put(bestBlockIDKey, b.Header().ID().Bytes()); err != nil {
 		return err
 	}
-	r.best.Store(b)
+	// store block and its summary together to keep them consistent
+	r.best.Store(&bestBlock{b, summary})
 	return nil
 }
 
 func (r *Repository) saveBlock(block *block.Block, receipts tx.Receipts, indexRoot thor.Bytes32) error {
-	return r.data.Batch(func(putter kv.PutFlusher) error {
-		var (
-			header  = block.Header()
-			id      = header.ID()
-			txs     = block.Transactions()
-			summary = BlockSummary{header, indexRoot, []thor.Bytes32{}, uint64(block.Size())}
-		)
+	var summary *BlockSummary
+	if err := r.data.Batch(func(putter kv.PutFlusher) (err error) {
+		summary, err = writeBlock(putter, block, receipts, indexRoot)
+		return
+	}); err != nil {
+		return err
+	}
+	r.cacheBlock(block, receipts, summary)
+	return nil
+}
 
-		if n := len(txs); n > 0 {
-			key := makeTxKey(id, txInfix)
-			for i, tx := range txs {
-				key.SetIndex(uint64(i))
-				if err := saveTransaction(putter, key, tx); err != nil {
-					return err
-				}
-				r.caches.txs.Add(key, tx)
-				summary.Txs = append(summary.Txs, tx.ID())
-			}
-			key = makeTxKey(id, receiptInfix)
-			for i, receipt := range receipts {
-				key.SetIndex(uint64(i))
-				if err := saveReceipt(putter, key, receipt); err != nil {
-					return err
-				}
-				r.caches.receipts.Add(key, receipt)
+// writeBlock writes block summary, txs and receipts.
+func writeBlock(putter kv.Putter, block *block.Block, receipts tx.Receipts, indexRoot thor.Bytes32) (*BlockSummary, error) {
+	var (
+		header  = block.Header()
+		id      = header.ID()
+		txs     = block.Transactions()
+		summary = BlockSummary{header, indexRoot, []thor.Bytes32{}, uint64(block.Size())}
+	)
+
+	if n := len(txs); n > 0 {
+		key := makeTxKey(id, txInfix)
+		for i, tx := range txs {
+			key.SetIndex(uint64(i))
+			if err := saveTransaction(putter, key, tx); err != nil {
+				return nil, err
 			}
+			summary.Txs = append(summary.Txs, tx.ID())
 		}
-		if err := saveBlockSummary(putter, &summary); err != nil {
-			return err
+		key = makeTxKey(id, receiptInfix)
+		for i, receipt := range receipts {
+			key.SetIndex(uint64(i))
+			if err := saveReceipt(putter, key, receipt); err != nil {
+				return nil, err
+			}
 		}
-		r.caches.summaries.Add(id, &summary)
-		return nil
-	})
+	}
+	if err := saveBlockSummary(putter, &summary); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// cacheBlock adds written block summary, txs and receipts into caches.
+func (r *Repository) cacheBlock(block *block.Block, receipts tx.Receipts, summary *BlockSummary) {
+	id := summary.Header.ID()
+	key := makeTxKey(id, txInfix)
+	for i, tx := range block.Transactions() {
+		key.SetIndex(uint64(i))
+		r.caches.txs.Add(key, tx)
+	}
+	key = makeTxKey(id, receiptInfix)
+	for i, receipt := range receipts {
+		key.SetIndex(uint64(i))
+		r.caches.receipts.Add(key, receipt)
+	}
+	r.caches.summaries.Add(id, summary)
 }
 
 // AddBlock add a new block with its receipts into repository.
 func (r *Repository) AddBlock(newBlock *block.Block, receipts tx.Receipts) error {
-	parentSummary, err := r.GetBlockSummary(newBlock.Header().ParentID())
+	return r.AddBlocks([]*block.Block{newBlock}, []tx.Receipts{receipts})
+}
+
+// AddBlocks add a run of blocks with their receipts into repository.
+// Each block must be the child of the previous one, and the parent of the first must exist.
+// Blocks are checked before any of them indexed. Summaries, txs and receipts of all blocks are
+// saved within a single batch, so none of them is saved if any error occurs.
+func (r *Repository) AddBlocks(blocks []*block.Block, receipts []tx.Receipts) error {
+	if len(blocks) != len(receipts) {
+		return errors.New("blocks count != receipts count")
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	// load the parent summary rather than HasBlock, since its index root is required
+	parentSummary, err := r.GetBlockSummary(blocks[0].Header().ParentID())
 	if err != nil {
 		if r.IsNotFound(err) {
 			return errors.New("parent missing")
 		}
 		return err
 	}
-	indexRoot, err := r.indexBlock(parentSummary.IndexRoot, newBlock, receipts)
-	if err != nil {
-		return err
+
+	parentID := parentSummary.Header.ID()
+	for _, b := range blocks {
+		if b.Header().ParentID() != parentID {
+			return errors.New("blocks not contiguous")
+		}
+		if uint64(b.Size()) > r.maxBlockSize {
+			return errBlockTooLarge
+		}
+		parentID = b.Header().ID()
 	}
 
-	if err := r.saveBlock(newBlock, receipts, indexRoot); err != nil {
+	var (
+		indexRoots = make([]thor.Bytes32, len(blocks))
+		indexRoot  = parentSummary.IndexRoot
+	)
+	for i, b := range blocks {
+		if indexRoot, err = r.indexBlock(indexRoot, b, receipts[i]); err != nil {
+			return err
+		}
+		indexRoots[i] = indexRoot
+	}
+
+	summaries := make([]*BlockSummary, len(blocks))
+	if err := r.data.Batch(func(putter kv.PutFlusher) error {
+		for i, b := range blocks {
+			summary, err := writeBlock(putter, b, receipts[i], indexRoots[i])
+			if err != nil {
+				return err
+			}
+			summaries[i] = summary
+		}
+		return nil
+	}); err != nil {
 		return err
 	}
+
+	for i, b := range blocks {
+		r.cacheBlock(b, receipts[i], summaries[i])
+	}
 	return nil
 }
 
@@ -208,6 +437,50 @@ func (r *Repository) GetBlockSummary(id thor.Bytes32) (summary *BlockSummary, er
 	return cached.(*BlockSummary), nil
 }
 
+// HasBlock check if the block with given id exists in the repository.
+// It's lighter than GetBlockSummary since no decoding is performed.
+func (r *Repository) HasBlock(id thor.Bytes32) (bool, error) {
+	if r.caches.summaries.Contains(id) {
+		return true, nil
+	}
+	return r.data.Has(id[:])
+}
+
+// IterateSummaries iterates block summaries from the block with given id back to the genesis of repository.
+// The iteration stops once fn returns false or error.
+func (r *Repository) IterateSummaries(fromID thor.Bytes32, fn func(*BlockSummary) (bool, error)) error {
+	id := fromID
+	for {
+		summary, err := r.GetBlockSummary(id)
+		if err != nil {
+			return err
+		}
+		if next, err := fn(summary); err != nil || !next {
+			return err
+		}
+		if summary.Header.ID() == r.genesis.Header().ID() {
+			return nil
+		}
+		id = summary.Header.ParentID()
+	}
+}
+
+// AncestorTrace returns at most depth summaries, from the block with given id back toward genesis.
+// It's intended for diagnosing chain structure.
+func (r *Repository) AncestorTrace(id thor.Bytes32, depth int) ([]*BlockSummary, error) {
+	var summaries []*BlockSummary
+	if depth <= 0 {
+		return summaries, nil
+	}
+	if err := r.IterateSummaries(id, func(summary *BlockSummary) (bool, error) {
+		summaries = append(summaries, summary)
+		return len(summaries) < depth, nil
+	}); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
 func (r *Repository) getTransaction(key txKey) (*tx.Transaction, error) {
 	cached, err := r.caches.txs.GetOrLoad(key, func() (interface{}, error) {
 		return loadTransaction(r.data, key)
@@ -285,9 +558,143 @@ func (r *Repository) GetBlockReceipts(id thor.Bytes32) (tx.Receipts, error) {
 	return nil, nil
 }
 
+// InvalidateBlock removes cached summary, txs and receipts of the given block.
+// Persisted data is not affected. It's used to evict blocks no longer on the trunk.
+func (r *Repository) InvalidateBlock(id thor.Bytes32) error {
+	var summary *BlockSummary
+	if cached, ok := r.caches.summaries.Peek(id); ok {
+		summary = cached.(*BlockSummary)
+	} else {
+		var err error
+		if summary, err = loadBlockSummary(r.data, id); err != nil {
+			if r.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	var (
+		txKey      = makeTxKey(id, txInfix)
+		receiptKey = makeTxKey(id, receiptInfix)
+	)
+	for i := range summary.Txs {
+		txKey.SetIndex(uint64(i))
+		receiptKey.SetIndex(uint64(i))
+		r.caches.txs.Remove(txKey)
+		r.caches.receipts.Remove(receiptKey)
+	}
+	r.caches.summaries.Remove(id)
+	return nil
+}
+
+// DeleteBlock deletes the block with given id, along with its txs and receipts.
+// Blocks on the best chain, including the genesis, can't be deleted.
+// It's for pruning side chains, and the caller should delete descendants before their ancestors,
+// since descendants of a deleted block can't be iterated back through it.
+func (r *Repository) DeleteBlock(id thor.Bytes32) error {
+	onBest, err := r.NewBestChain().HasBlock(id)
+	if err != nil {
+		return err
+	}
+	if onBest {
+		return errors.New("can't delete block on best chain")
+	}
+
+	summary, err := r.GetBlockSummary(id)
+	if err != nil {
+		return err
+	}
+	if err := r.data.Batch(func(putter kv.PutFlusher) error {
+		var (
+			txKey      = makeTxKey(id, txInfix)
+			receiptKey = makeTxKey(id, receiptInfix)
+		)
+		for i := range summary.Txs {
+			txKey.SetIndex(uint64(i))
+			receiptKey.SetIndex(uint64(i))
+			if err := putter.Delete(txKey[:]); err != nil {
+				return err
+			}
+			if err := putter.Delete(receiptKey[:]); err != nil {
+				return err
+			}
+		}
+		return putter.Delete(id[:])
+	}); err != nil {
+		return err
+	}
+	return r.InvalidateBlock(id)
+}
+
+// Stats returns statistics of caches and the data store.
+func (r *Repository) Stats() RepositoryStats {
+	return RepositoryStats{
+		Summaries:  r.caches.summaries.Stats(),
+		Txs:        r.caches.txs.Stats(),
+		Receipts:   r.caches.receipts.Stats(),
+		StoreReads: atomic.LoadUint64(&r.data.reads),
+	}
+}
+
+// Close closes the repository. It's safe to call Close more than once.
+// Any access to stored data after closed returns an error.
+// The underlying db is not closed, and is safe to be closed by its owner after this call.
+func (r *Repository) Close() error {
+	if atomic.CompareAndSwapInt32(&r.data.closed, 0, 1) {
+		r.caches.summaries.Purge()
+		r.caches.txs.Purge()
+		r.caches.receipts.Purge()
+		r.feedScope.Close()
+	}
+	return nil
+}
+
 // IsNotFound returns if the given error means not found.
 func (r *Repository) IsNotFound(err error) bool {
-	return err == errNotFound || r.db.IsNotFound(err)
+	if err == errNotFound || r.db.IsNotFound(err) {
+		return true
+	}
+	return r.isNotFound != nil && r.isNotFound(err)
+}
+
+// IsNotFinalized returns if the error means the block is not finalized.
+func IsNotFinalized(err error) bool {
+	return err == errNotFinalized
+}
+
+// SubscribeBestBlock subscribes to best block changes, and the summary of the new best block
+// is delivered to ch. The delivery never blocks SetBestBlockID. A subscriber lagging behind
+// skips intermediate changes, and receives the latest best block.
+// Subscriptions are closed when the repository is closed.
+func (r *Repository) SubscribeBestBlock(ch chan<- *BlockSummary) event.Subscription {
+	ticker := r.NewTicker()
+	sub := event.NewSubscription(func(quit <-chan struct{}) error {
+		var (
+			pending *BlockSummary
+			out     chan<- *BlockSummary // nil if nothing pending
+		)
+		for {
+			select {
+			case <-quit:
+				return nil
+			case <-ticker.C():
+				pending, out = r.BestBlockSummary(), ch
+			case out <- pending:
+				pending, out = nil, nil
+			}
+		}
+	})
+	if r.feedScope.Track(sub) == nil {
+		// the repository is closed
+		sub.Unsubscribe()
+	}
+	return sub
+}
+
+// IsBlockTooLarge returns if the error means the block exceeds the max block size.
+func IsBlockTooLarge(err error) bool {
+	return err == errBlockTooLarge
 }
 
 // NewTicker create a signal Waiter to receive event that the best block changed.
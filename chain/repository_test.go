@@ -6,7 +6,9 @@
 package chain_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +17,7 @@ import (
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
 
@@ -68,6 +71,9 @@ func TestRepository(t *testing.T) {
 	b1 := newBlock(repo1.GenesisBlock(), 10, tx1)
 	assert.Nil(t, repo1.AddBlock(b1, tx.Receipts{receipt1}))
 
+	assert.Equal(t, M(true, nil), M(repo1.HasBlock(b1.Header().ID())))
+	assert.Equal(t, M(false, nil), M(repo1.HasBlock(newBlock(b1, 20).Header().ID())))
+
 	// best block not set, so still 0
 	assert.Equal(t, uint32(0), repo1.BestBlock().Header().Number())
 
@@ -76,11 +82,17 @@ func TestRepository(t *testing.T) {
 	for _, repo := range []*Repository{repo1, repo2} {
 
 		assert.Equal(t, b1.Header().ID(), repo.BestBlock().Header().ID())
+		assert.Equal(t, b1.Header().ID(), repo.BestBlockSummary().Header.ID())
+		assert.Equal(t, M(true, nil), M(repo.HasBlock(b1.Header().ID())))
+		assert.Equal(t, uint64(b1.Size()), repo.BestBlockSummary().Size)
 		s, err := repo.GetBlockSummary(b1.Header().ID())
 		assert.Nil(t, err)
 		assert.Equal(t, b1.Header().ID(), s.Header.ID())
 		assert.Equal(t, 1, len(s.Txs))
 		assert.Equal(t, tx1.ID(), s.Txs[0])
+		assert.Equal(t, uint64(b1.Size()), s.Size)
+		assert.Equal(t, b1.Header().Number(), s.Number())
+		assert.Equal(t, b1.Header().TotalScore(), s.TotalScore())
 
 		gotb, _ := repo.GetBlock(b1.Header().ID())
 		assert.Equal(t, b1.Transactions().RootHash(), gotb.Transactions().RootHash())
@@ -88,5 +100,396 @@ func TestRepository(t *testing.T) {
 		gotReceipts, _ := repo.GetBlockReceipts(b1.Header().ID())
 
 		assert.Equal(t, tx.Receipts{receipt1}.RootHash(), gotReceipts.RootHash())
+
+		// invalidated block can still be loaded from store
+		assert.Nil(t, repo.InvalidateBlock(b1.Header().ID()))
+		gotb, _ = repo.GetBlock(b1.Header().ID())
+		assert.Equal(t, b1.Transactions().RootHash(), gotb.Transactions().RootHash())
+	}
+	assert.Nil(t, repo1.InvalidateBlock(newBlock(b1, 20).Header().ID()))
+}
+
+func TestRepositoryWithOptions(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+
+	repo, err := NewRepositoryWithOptions(db, b0, RepositoryOptions{SummaryCacheSize: 16})
+	assert.Nil(t, err)
+
+	b1 := newBlock(repo.GenesisBlock(), 10)
+	assert.Nil(t, repo.AddBlock(b1, nil))
+	s, err := repo.GetBlockSummary(b1.Header().ID())
+	assert.Nil(t, err)
+	assert.Equal(t, b1.Header().ID(), s.Header.ID())
+
+	_, err = NewRepositoryWithOptions(db, b0, RepositoryOptions{TxCacheSize: -1})
+	assert.Equal(t, "invalid tx cache size -1", err.Error())
+}
+
+func TestRepositoryStats(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+	repo, _ := NewRepository(db, b0)
+
+	b1 := newBlock(repo.GenesisBlock(), 10)
+	assert.Nil(t, repo.AddBlock(b1, nil))
+
+	// reopen to start with empty caches
+	repo, _ = NewRepository(db, b0)
+	stats := repo.Stats()
+
+	repo.GetBlockSummary(b1.Header().ID())
+	loaded := repo.Stats()
+	assert.Equal(t, stats.Summaries.Hit, loaded.Summaries.Hit)
+	assert.Equal(t, stats.Summaries.Miss+1, loaded.Summaries.Miss)
+	assert.Equal(t, stats.StoreReads+1, loaded.StoreReads)
+
+	repo.GetBlockSummary(b1.Header().ID())
+	hit := repo.Stats()
+	assert.Equal(t, loaded.Summaries.Hit+1, hit.Summaries.Hit)
+	assert.Equal(t, loaded.Summaries.Miss, hit.Summaries.Miss)
+	assert.Equal(t, loaded.StoreReads, hit.StoreReads)
+}
+
+func TestAncestorTrace(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+	b1 := newBlock(b0, 10)
+	repo.AddBlock(b1, nil)
+	b2 := newBlock(b1, 20)
+	repo.AddBlock(b2, nil)
+
+	ids := func(summaries []*BlockSummary) (ids []thor.Bytes32) {
+		for _, s := range summaries {
+			ids = append(ids, s.Header.ID())
+		}
+		return
+	}
+
+	trace, err := repo.AncestorTrace(b2.Header().ID(), 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []thor.Bytes32{b2.Header().ID(), b1.Header().ID()}, ids(trace))
+
+	// stop at genesis
+	trace, err = repo.AncestorTrace(b2.Header().ID(), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []thor.Bytes32{b2.Header().ID(), b1.Header().ID(), b0.Header().ID()}, ids(trace))
+
+	_, err = repo.AncestorTrace(newBlock(b2, 30).Header().ID(), 10)
+	assert.True(t, repo.IsNotFound(err))
+}
+
+func TestAddBlocks(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+
+	tx1 := new(tx.Builder).Build()
+	b1 := newBlock(b0, 10, tx1)
+	b2 := newBlock(b1, 20)
+	b3 := newBlock(b2, 30)
+
+	// not contiguous
+	assert.Equal(t, "blocks not contiguous",
+		repo.AddBlocks([]*block.Block{b1, b3}, []tx.Receipts{{&tx.Receipt{}}, nil}).Error())
+	_, err := repo.GetBlockSummary(b1.Header().ID())
+	assert.True(t, repo.IsNotFound(err))
+
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2, b3}, []tx.Receipts{{&tx.Receipt{}}, nil, nil}))
+	assert.Nil(t, repo.SetBestBlockID(b3.Header().ID()))
+
+	c := repo.NewBestChain()
+	for _, b := range []*block.Block{b1, b2, b3} {
+		assert.Equal(t, M(b.Header().ID(), nil), M(c.GetBlockID(b.Header().Number())))
+	}
+	assert.Equal(t, M(&TxMeta{BlockID: b1.Header().ID()}, nil), M(c.GetTransactionMeta(tx1.ID())))
+
+	assert.Equal(t, "parent missing",
+		repo.AddBlocks([]*block.Block{newBlock(newBlock(b3, 40), 50)}, []tx.Receipts{nil}).Error())
+}
+
+func TestRepositoryClose(t *testing.T) {
+	repo := newTestRepo()
+	b1 := newBlock(repo.GenesisBlock(), 10)
+	assert.Nil(t, repo.AddBlock(b1, nil))
+	assert.Nil(t, repo.SetBestBlockID(b1.Header().ID()))
+
+	// init the index trie of the best chain
+	best := repo.NewBestChain()
+	assert.Equal(t, M(b1.Header().ID(), nil), M(best.GetBlockID(1)))
+
+	assert.Nil(t, repo.Close())
+	assert.Nil(t, repo.Close())
+
+	for _, c := range []*Chain{best, repo.NewBestChain()} {
+		_, err := c.GetBlockID(1)
+		assert.Equal(t, "repository closed", err.Error())
+		_, err = c.GetTransactionMeta(thor.Bytes32{})
+		assert.Equal(t, "repository closed", err.Error())
+	}
+	_, _, err := repo.CanonicalIDAt(1)
+	assert.Equal(t, "repository closed", err.Error())
+	_, err = repo.GetBlockByNumber(1)
+	assert.Equal(t, "repository closed", err.Error())
+	assert.Equal(t, "repository closed", repo.SetBestBlockID(b1.Header().ID()).Error())
+
+	_, err = repo.GetBlock(b1.Header().ID())
+	assert.Equal(t, "repository closed", err.Error())
+	assert.False(t, repo.IsNotFound(err))
+	assert.Equal(t, "repository closed", repo.AddBlock(newBlock(b1, 20), nil).Error())
+}
+
+func TestIterateSummaries(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+	b1 := newBlock(b0, 10)
+	repo.AddBlock(b1, nil)
+	b2 := newBlock(b1, 20)
+	repo.AddBlock(b2, nil)
+
+	var nums []uint32
+	assert.Nil(t, repo.IterateSummaries(b2.Header().ID(), func(s *BlockSummary) (bool, error) {
+		nums = append(nums, s.Header.Number())
+		return true, nil
+	}))
+	assert.Equal(t, []uint32{2, 1, 0}, nums)
+
+	// halt early
+	nums = nil
+	assert.Nil(t, repo.IterateSummaries(b2.Header().ID(), func(s *BlockSummary) (bool, error) {
+		nums = append(nums, s.Header.Number())
+		return s.Header.Number() > 1, nil
+	}))
+	assert.Equal(t, []uint32{2, 1}, nums)
+
+	err := errors.New("halt")
+	assert.Equal(t, err, repo.IterateSummaries(b2.Header().ID(), func(s *BlockSummary) (bool, error) {
+		return true, err
+	}))
+}
+
+func TestRepositoryFromCheckpoint(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+	b1 := newBlock(b0, 10)
+	repo.AddBlock(b1, nil)
+	tx1 := new(tx.Builder).Build()
+	b2 := newBlock(b1, 20, tx1)
+	repo.AddBlock(b2, tx.Receipts{&tx.Receipt{}})
+
+	db := muxdb.NewMem()
+	forked, err := NewRepositoryFromCheckpoint(db, b2, tx.Receipts{&tx.Receipt{}})
+	assert.Nil(t, err)
+	assert.Equal(t, b2.Header().ID(), forked.GenesisBlock().Header().ID())
+	assert.Equal(t, b2.Header().ID(), forked.BestBlock().Header().ID())
+	assert.Equal(t, b2.Header().ID()[31], forked.ChainTag())
+
+	b3 := newBlock(b2, 30)
+	assert.Nil(t, forked.AddBlock(b3, nil))
+	assert.Nil(t, forked.SetBestBlockID(b3.Header().ID()))
+
+	// reopen
+	forked, err = NewRepositoryFromCheckpoint(db, b2, tx.Receipts{&tx.Receipt{}})
+	assert.Nil(t, err)
+
+	c := forked.NewBestChain()
+	assert.Equal(t, M(b2.Header().ID(), nil), M(c.GetBlockID(2)))
+	assert.Equal(t, M(b3.Header().ID(), nil), M(c.GetBlockID(3)))
+	assert.Equal(t, M(&TxMeta{BlockID: b2.Header().ID()}, nil), M(c.GetTransactionMeta(tx1.ID())))
+	_, err = c.GetBlockID(1)
+	assert.True(t, forked.IsNotFound(err))
+
+	trace, err := forked.AncestorTrace(b3.Header().ID(), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(trace))
+
+	_, err = NewRepositoryFromCheckpoint(db, b1, nil)
+	assert.NotNil(t, err)
+	_, err = NewRepositoryFromCheckpoint(db, newBlock(b1, 20), nil)
+	assert.Equal(t, "genesis mismatch", err.Error())
+
+	// with options
+	forked, err = NewRepositoryFromCheckpointWithOptions(muxdb.NewMem(), b2, tx.Receipts{&tx.Receipt{}}, RepositoryOptions{MaxBlockSize: 1})
+	assert.Nil(t, err)
+	assert.True(t, IsBlockTooLarge(forked.AddBlock(b3, nil)))
+	_, err = NewRepositoryFromCheckpointWithOptions(muxdb.NewMem(), b2, tx.Receipts{&tx.Receipt{}}, RepositoryOptions{TxCacheSize: -1})
+	assert.Equal(t, "invalid tx cache size -1", err.Error())
+}
+
+func TestDeleteBlock(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+	b1 := newBlock(b0, 10)
+	repo.AddBlock(b1, nil)
+	b2 := newBlock(b1, 20)
+	repo.AddBlock(b2, nil)
+	repo.SetBestBlockID(b2.Header().ID())
+
+	// side chain
+	tx1 := new(tx.Builder).Build()
+	b1x := newBlock(b0, 11, tx1)
+	repo.AddBlock(b1x, tx.Receipts{&tx.Receipt{}})
+
+	for _, b := range []*block.Block{b0, b1, b2} {
+		assert.Equal(t, "can't delete block on best chain", repo.DeleteBlock(b.Header().ID()).Error())
+	}
+
+	assert.Nil(t, repo.DeleteBlock(b1x.Header().ID()))
+
+	_, err := repo.GetBlock(b1x.Header().ID())
+	assert.True(t, repo.IsNotFound(err))
+	_, err = repo.GetBlockTransactions(b1x.Header().ID())
+	assert.True(t, repo.IsNotFound(err))
+	assert.Equal(t, M(false, nil), M(repo.HasBlock(b1x.Header().ID())))
+	assert.True(t, repo.IsNotFound(repo.DeleteBlock(b1x.Header().ID())))
+
+	// the best chain is intact
+	_, err = repo.GetBlockByNumber(1)
+	assert.Nil(t, err)
+}
+
+func TestMaxBlockSize(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+
+	b1 := newBlock(b0, 10, newTx())
+	repo, err := NewRepositoryWithOptions(db, b0, RepositoryOptions{MaxBlockSize: uint64(b1.Size()) - 1})
+	assert.Nil(t, err)
+
+	assert.True(t, IsBlockTooLarge(repo.AddBlock(b1, tx.Receipts{&tx.Receipt{}})))
+	assert.Equal(t, M(false, nil), M(repo.HasBlock(b1.Header().ID())))
+	_, err = repo.GetTransactionMeta(b1.Transactions()[0].ID())
+	assert.True(t, repo.IsNotFound(err))
+
+	// an oversized block fails the whole run
+	b1x := newBlock(b0, 10)
+	b2x := newBlock(b1x, 20, newTx())
+	assert.True(t, IsBlockTooLarge(repo.AddBlocks(
+		[]*block.Block{b1x, b2x},
+		[]tx.Receipts{nil, {&tx.Receipt{}}})))
+	assert.Equal(t, M(false, nil), M(repo.HasBlock(b1x.Header().ID())))
+
+	assert.Nil(t, repo.AddBlock(b1x, nil))
+}
+
+func TestParallelIndexing(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+
+	var (
+		txs      tx.Transactions
+		receipts tx.Receipts
+	)
+	for i := 0; i < 10; i++ {
+		txs = append(txs, newTx())
+		receipts = append(receipts, &tx.Receipt{Reverted: i%3 == 0})
+	}
+	b1 := newBlock(b0, 10, txs...)
+
+	indexRoot := func(workers int) thor.Bytes32 {
+		repo, err := NewRepositoryWithOptions(muxdb.NewMem(), b0, RepositoryOptions{IndexWorkers: workers})
+		assert.Nil(t, err)
+		assert.Nil(t, repo.AddBlock(b1, receipts))
+		s, err := repo.GetBlockSummary(b1.Header().ID())
+		assert.Nil(t, err)
+
+		for i, tx := range txs {
+			meta, err := repo.NewChain(b1.Header().ID()).GetTransactionMeta(tx.ID())
+			assert.Nil(t, err)
+			assert.Equal(t, &TxMeta{BlockID: b1.Header().ID(), Index: uint64(i), Reverted: receipts[i].Reverted}, meta)
+		}
+		return s.IndexRoot
+	}
+
+	serial := indexRoot(0)
+	assert.Equal(t, serial, indexRoot(4))
+	assert.Equal(t, serial, indexRoot(32))
+
+	_, err := NewRepositoryWithOptions(db, b0, RepositoryOptions{IndexWorkers: -1})
+	assert.Equal(t, "invalid index workers -1", err.Error())
+}
+
+func TestSubscribeBestBlock(t *testing.T) {
+	repo := newTestRepo()
+
+	recv := func(ch <-chan *BlockSummary) thor.Bytes32 {
+		select {
+		case s := <-ch:
+			return s.Header.ID()
+		case <-time.After(time.Second):
+			t.Fatal("no best block received")
+		}
+		return thor.Bytes32{}
+	}
+
+	ch := make(chan *BlockSummary)
+	sub := repo.SubscribeBestBlock(ch)
+	// never drained, should not block SetBestBlockID
+	slowCh := make(chan *BlockSummary)
+	repo.SubscribeBestBlock(slowCh)
+
+	b1 := newBlock(repo.GenesisBlock(), 10)
+	repo.AddBlock(b1, nil)
+	assert.Nil(t, repo.SetBestBlockID(b1.Header().ID()))
+	assert.Equal(t, b1.Header().ID(), recv(ch))
+
+	// no event on failure
+	assert.NotNil(t, repo.SetBestBlockID(thor.Bytes32{}))
+	b2 := newBlock(b1, 20)
+	repo.AddBlock(b2, nil)
+	assert.Nil(t, repo.SetBestBlockID(b2.Header().ID()))
+	assert.Equal(t, b2.Header().ID(), recv(ch))
+
+	// the slow subscriber skips to the latest
+	assert.Equal(t, b2.Header().ID(), recv(slowCh))
+
+	sub.Unsubscribe()
+	b3 := newBlock(b2, 30)
+	repo.AddBlock(b3, nil)
+	assert.Nil(t, repo.SetBestBlockID(b3.Header().ID()))
+	select {
+	case <-ch:
+		t.Fatal("received after unsubscribed")
+	case <-time.After(time.Millisecond * 10):
 	}
+
+	sub = repo.SubscribeBestBlock(ch)
+	repo.Close()
+	_, ok := <-sub.Err()
+	assert.False(t, ok)
+
+	// subscribed after closed
+	sub = repo.SubscribeBestBlock(ch)
+	assert.NotNil(t, sub)
+	_, ok = <-sub.Err()
+	assert.False(t, ok)
+	sub.Unsubscribe()
+}
+
+type storeError struct{ cause error }
+
+func (e *storeError) Error() string { return "store: " + e.cause.Error() }
+
+func TestCustomIsNotFound(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+
+	_, err := newTestRepo().GetBlock(thor.Bytes32{})
+	wrapped := &storeError{err}
+
+	repo, _ := NewRepository(db, b0)
+	assert.True(t, repo.IsNotFound(err))
+	assert.False(t, repo.IsNotFound(wrapped))
+
+	repo, _ = NewRepositoryWithOptions(db, b0, RepositoryOptions{
+		IsNotFound: func(err error) bool {
+			if e, ok := err.(*storeError); ok {
+				return repo.IsNotFound(e.cause)
+			}
+			return false
+		},
+	})
+	assert.True(t, repo.IsNotFound(err))
+	assert.True(t, repo.IsNotFound(wrapped))
+	assert.True(t, repo.NewBestChain().IsNotFound(wrapped))
+	assert.False(t, repo.IsNotFound(errors.New("other")))
 }
@@ -159,6 +159,7 @@ func defaultAction(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	defer func() { log.Info("closing chain repository..."); repo.Close() }()
 
 	master, err := loadNodeMaster(ctx)
 	if err != nil {
@@ -262,6 +263,7 @@ func soloAction(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	defer func() { log.Info("closing chain repository..."); repo.Close() }()
 
 	skipLogs := ctx.Bool(skipLogsFlag.Name)
 
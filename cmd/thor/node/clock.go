@@ -0,0 +1,26 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package node
+
+import "time"
+
+// Clock is the time source used by the packer loop.
+type Clock interface {
+	// Now returns the current unix timestamp in seconds.
+	Now() uint64
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+type wallClock struct{}
+
+func (wallClock) Now() uint64 {
+	return uint64(time.Now().Unix())
+}
+
+func (wallClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
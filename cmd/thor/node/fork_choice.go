@@ -0,0 +1,28 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package node
+
+import (
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+)
+
+// ForkChoice decides which block should be the best, between the current best block and a new one.
+type ForkChoice interface {
+	// Select returns id of the selected block, which must be either current or candidate.
+	// The candidate block is not yet in the repository when called.
+	Select(current, candidate *block.Header) (thor.Bytes32, error)
+}
+
+// scoreForkChoice prefers the block with higher total score, which is the default rule.
+type scoreForkChoice struct{}
+
+func (scoreForkChoice) Select(current, candidate *block.Header) (thor.Bytes32, error) {
+	if candidate.BetterThan(current) {
+		return candidate.ID(), nil
+	}
+	return current.ID(), nil
+}
@@ -54,6 +54,17 @@ type Node struct {
 	skipLogs       bool
 	logDBFailed    bool
 	bandwidth      bandwidth.Bandwidth
+	clock          Clock
+	forkChoice     ForkChoice
+
+	syncWaitTimeout time.Duration
+
+	packerLock sync.Mutex
+	flow       *packer.Flow
+	authorized bool
+
+	timingLock sync.Mutex
+	timingSubs map[*timingSub]struct{}
 }
 
 func New(
@@ -79,9 +90,31 @@ func New(
 		comm:           comm,
 		targetGasLimit: targetGasLimit,
 		skipLogs:       skipLogs,
+		clock:          wallClock{},
+		forkChoice:     scoreForkChoice{},
+		timingSubs:     make(map[*timingSub]struct{}),
 	}
 }
 
+// SetClock replaces the time source of the packer loop, which defaults to the wall clock.
+// It should be called before Run.
+func (n *Node) SetClock(clock Clock) {
+	n.clock = clock
+}
+
+// SetForkChoice replaces the rule to choose the best block, which defaults to the higher total score.
+// It should be called before Run.
+func (n *Node) SetForkChoice(forkChoice ForkChoice) {
+	n.forkChoice = forkChoice
+}
+
+// SetSyncWaitTimeout makes the packer loop start packing after the given timeout,
+// even if the synchronization process is not done. Zero means waiting forever, which is the default.
+// It should be called before Run.
+func (n *Node) SetSyncWaitTimeout(timeout time.Duration) {
+	n.syncWaitTimeout = timeout
+}
+
 func (n *Node) Run(ctx context.Context) error {
 	n.comm.Sync(n.handleBlockStream)
 
@@ -290,9 +323,17 @@ func (n *Node) commitBlock(stage *state.Stage, newBlock *block.Block, receipts t
 	n.commitLock.Lock()
 	defer n.commitLock.Unlock()
 
+	prevBest := n.repo.BestBlock()
+	selected, err := n.forkChoice.Select(prevBest.Header(), newBlock.Header())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "fork choice")
+	}
+	if selected != prevBest.Header().ID() && selected != newBlock.Header().ID() {
+		return nil, nil, errors.Errorf("fork choice: unexpected block selected %v", selected)
+	}
+
 	var (
-		prevBest      = n.repo.BestBlock()
-		becomeNewBest = newBlock.Header().BetterThan(prevBest.Header())
+		becomeNewBest = selected == newBlock.Header().ID()
 		awaitLog      = func() {}
 	)
 	defer awaitLog()
@@ -377,6 +418,8 @@ side-chain:   %v  %v`,
 			n, sideIds[n-1]))
 	}
 
+	// txs will be packed into the block next to the current trunk head
+	nextNum := block.Number(curTrunk.HeadID()) + 1
 	for _, id := range sideIds {
 		b, err := n.repo.GetBlock(id)
 		if err != nil {
@@ -384,10 +427,24 @@ side-chain:   %v  %v`,
 			return
 		}
 		for _, tx := range b.Transactions() {
+			if tx.IsExpired(nextNum) {
+				continue
+			}
+			// skip txs already included by the new trunk
+			if _, err := curTrunk.GetTransactionMeta(tx.ID()); err == nil {
+				continue
+			} else if !curTrunk.IsNotFound(err) {
+				log.Warn("failed to process fork", "err", err)
+				return
+			}
 			if err := n.txPool.Add(tx); err != nil {
 				log.Debug("failed to add tx to tx pool", "err", err, "id", tx.ID())
 			}
 		}
+		// keep caches hot with trunk data
+		if err := n.repo.InvalidateBlock(id); err != nil {
+			log.Warn("failed to invalidate block", "err", err, "id", id)
+		}
 	}
 }
 
@@ -0,0 +1,332 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package node
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/comm"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+func newTestNode(t *testing.T) *Node {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, err := genesis.NewDevnet().Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := chain.NewRepository(db, b0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txPool := txpool.New(repo, stater, txpool.Options{Limit: 100, LimitPerAccount: 16, MaxLifetime: time.Hour})
+
+	master := &Master{PrivateKey: genesis.DevAccounts()[0].PrivateKey}
+	return New(master, repo, stater, nil, txPool, "", comm.New(repo, txPool), 0, true, thor.NoFork)
+}
+
+func TestPackNow(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	for i := 1; i <= 3; i++ {
+		blk, err := n.PackNow(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(i), blk.Header().Number())
+		assert.Equal(t, blk.Header().ID(), n.repo.BestBlock().Header().ID())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := n.PackNow(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestPackerStatus(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	assert.Equal(t, PackerStatus{}, n.PackerStatus())
+
+	best := n.repo.BestBlock().Header()
+	flow, err := n.packer.Schedule(best, uint64(time.Now().Unix()))
+	assert.Nil(t, err)
+
+	n.setPackerState(flow, true)
+	assert.Equal(t, PackerStatus{
+		Authorized: true,
+		Scheduled:  true,
+		When:       flow.When(),
+		ParentID:   best.ID(),
+	}, n.PackerStatus())
+
+	// zero value if no flow scheduled, even authorized
+	n.setPackerState(nil, true)
+	assert.Equal(t, PackerStatus{}, n.PackerStatus())
+}
+
+type fakeClock struct {
+	now uint64
+}
+
+func (c *fakeClock) Now() uint64 { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.now += uint64(d / time.Second)
+	ch := make(chan time.Time, 1)
+	ch <- time.Unix(int64(c.now), 0)
+	return ch
+}
+
+func TestPackWithClock(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	clock := &fakeClock{now: n.repo.GenesisBlock().Header().Timestamp() + thor.BlockInterval*100}
+	n.SetClock(clock)
+
+	blk, err := n.PackNow(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, blk.Header().Timestamp() >= clock.now)
+
+	<-clock.After(time.Duration(thor.BlockInterval) * time.Second)
+	blk2, err := n.PackNow(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, blk.Header().Timestamp()+thor.BlockInterval, blk2.Header().Timestamp())
+}
+
+func newSignedTx(chainTag byte, expiration uint32) *tx.Transaction {
+	trx := new(tx.Builder).
+		ChainTag(chainTag).
+		Expiration(expiration).
+		Gas(21000).
+		Nonce(rand.Uint64()).
+		Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	return trx.WithSignature(sig)
+}
+
+func newForkBlock(t *testing.T, repo *chain.Repository, parent *block.Header, txs ...*tx.Transaction) *block.Block {
+	builder := new(block.Builder).
+		ParentID(parent.ID()).
+		Timestamp(parent.Timestamp() + thor.BlockInterval).
+		TotalScore(parent.TotalScore() + 1)
+	receipts := make(tx.Receipts, 0, len(txs))
+	for _, trx := range txs {
+		builder.Transaction(trx)
+		receipts = append(receipts, &tx.Receipt{})
+	}
+	b := builder.Build()
+	sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	b = b.WithSignature(sig)
+	if err := repo.AddBlock(b, receipts); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestProcessForkReinjectTxs(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	var (
+		tag      = n.repo.ChainTag()
+		b0       = n.repo.GenesisBlock().Header()
+		dropped  = newSignedTx(tag, math.MaxUint32)
+		included = newSignedTx(tag, math.MaxUint32)
+		expired  = newSignedTx(tag, 0)
+	)
+
+	b1 := newForkBlock(t, n.repo, b0, included)
+	b2 := newForkBlock(t, n.repo, b1.Header())
+	b1x := newForkBlock(t, n.repo, b0, dropped, included, expired)
+
+	n.processFork(n.repo.NewChain(b1x.Header().ID()), n.repo.NewChain(b2.Header().ID()))
+
+	assert.Equal(t, tx.Transactions{dropped}, n.txPool.Dump())
+}
+
+func TestPackerLoopSyncWaitTimeout(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	// the communicator is not started, so it never gets synced
+	n.SetClock(&fakeClock{now: n.repo.GenesisBlock().Header().Timestamp() + thor.BlockInterval*100})
+	// elapses at once on the fake clock
+	n.SetSyncWaitTimeout(time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		n.packerLoop(ctx)
+		close(done)
+	}()
+
+	ticker := n.repo.NewTicker()
+	select {
+	case <-ticker.C():
+	case <-time.After(time.Second * 5):
+		t.Fatal("no block packed")
+	}
+	cancel()
+	<-done
+
+	assert.True(t, n.repo.BestBlock().Header().Number() > 0)
+}
+
+func TestAdoptTxs(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	flow, err := n.packer.Schedule(n.repo.BestBlock().Header(), uint64(time.Now().Unix()))
+	assert.Nil(t, err)
+
+	var (
+		tag    = n.repo.ChainTag()
+		tx1    = newSignedTx(tag, math.MaxUint32)
+		tx2    = newSignedTx(tag, math.MaxUint32)
+		badTag = newSignedTx(tag+1, math.MaxUint32)
+	)
+
+	stats, txsToRemove, err := adoptTxs(context.Background(), flow, tx.Transactions{tx1, tx1, badTag, tx2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, stats.Adopted)
+	assert.Equal(t, 0, stats.GasRejected)
+	assert.Equal(t, 2, stats.Removed)
+	assert.Equal(t, []*tx.Transaction{tx1, badTag}, txsToRemove)
+}
+
+func TestPackCancelled(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	best := n.repo.BestBlock().Header()
+	flow, err := n.packer.Schedule(best, uint64(time.Now().Unix()))
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tag := n.repo.ChainTag()
+	stats, _, err := adoptTxs(ctx, flow, tx.Transactions{newSignedTx(tag, math.MaxUint32)})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0, stats.Adopted)
+
+	_, _, err = n.pack(ctx, flow)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, best.ID(), n.repo.BestBlock().Header().ID())
+}
+
+// pinnedForkChoice only accepts blocks descending from the pinned block.
+type pinnedForkChoice struct {
+	repo *chain.Repository
+	pin  thor.Bytes32
+}
+
+func (p *pinnedForkChoice) Select(current, candidate *block.Header) (thor.Bytes32, error) {
+	has, err := p.repo.NewChain(candidate.ParentID()).HasBlock(p.pin)
+	if err != nil {
+		return thor.Bytes32{}, err
+	}
+	if has || candidate.ID() == p.pin {
+		return scoreForkChoice{}.Select(current, candidate)
+	}
+	return current.ID(), nil
+}
+
+type fixedForkChoice thor.Bytes32
+
+func (f fixedForkChoice) Select(current, candidate *block.Header) (thor.Bytes32, error) {
+	return thor.Bytes32(f), nil
+}
+
+func TestForkChoiceUnexpected(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	b0 := n.repo.GenesisBlock().Header()
+	n.SetForkChoice(fixedForkChoice{})
+
+	_, err := n.PackNow(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, b0.ID(), n.repo.BestBlock().Header().ID())
+}
+
+func TestForkChoice(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	b0 := n.repo.GenesisBlock().Header()
+	b1, err := n.PackNow(context.Background())
+	assert.Nil(t, err)
+	n.SetForkChoice(&pinnedForkChoice{n.repo, b1.Header().ID()})
+
+	// a side block never becomes best
+	flow, err := n.packer.Schedule(b0, b1.Header().Timestamp()+thor.BlockInterval*10)
+	assert.Nil(t, err)
+	b1x, stage, receipts, err := flow.Pack(genesis.DevAccounts()[0].PrivateKey)
+	assert.Nil(t, err)
+	_, _, err = n.commitBlock(stage, b1x, receipts)
+	assert.Nil(t, err)
+	has, err := n.repo.HasBlock(b1x.Header().ID())
+	assert.Nil(t, err)
+	assert.True(t, has)
+	assert.Equal(t, b1.Header().ID(), n.repo.BestBlock().Header().ID())
+
+	b2, err := n.PackNow(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, b1.Header().ID(), b2.Header().ParentID())
+	assert.Equal(t, b2.Header().ID(), n.repo.BestBlock().Header().ID())
+}
+
+func TestSubscribeRoundTiming(t *testing.T) {
+	n := newTestNode(t)
+	defer n.txPool.Close()
+
+	ch := make(chan *RoundTiming, 1)
+	sub := n.SubscribeRoundTiming(ch)
+	// never drained, should not block the packer
+	n.SubscribeRoundTiming(make(chan *RoundTiming))
+
+	_, err := n.PackNow(context.Background())
+	assert.Nil(t, err)
+
+	select {
+	case timing := <-ch:
+		assert.True(t, timing.Adopt >= 0)
+		assert.True(t, timing.Pack > 0)
+		assert.True(t, timing.Commit > 0)
+	default:
+		t.Fatal("no timing received")
+	}
+
+	// dropped when ch is full
+	_, err = n.PackNow(context.Background())
+	assert.Nil(t, err)
+	_, err = n.PackNow(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ch))
+	<-ch
+
+	sub.Unsubscribe()
+	_, err = n.PackNow(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ch))
+}
@@ -12,7 +12,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/mclock"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/inconshreveable/log15"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -23,22 +26,29 @@ func (n *Node) packerLoop(ctx context.Context) {
 	defer log.Debug("leave packer loop")
 
 	log.Info("waiting for synchronization...")
+	var syncTimeout <-chan time.Time
+	if n.syncWaitTimeout > 0 {
+		syncTimeout = n.clock.After(n.syncWaitTimeout)
+	}
 	select {
 	case <-ctx.Done():
 		return
 	case <-n.comm.Synced():
+		log.Info("synchronization process done")
+	case <-syncTimeout:
+		log.Warn("synchronization process not done in time, start packing anyway", "timeout", n.syncWaitTimeout)
 	}
-	log.Info("synchronization process done")
 
 	var (
 		authorized bool
 		ticker     = n.repo.NewTicker()
 	)
+	defer n.setPackerState(nil, false)
 
 	n.packer.SetTargetGasLimit(n.targetGasLimit)
 
 	for {
-		now := uint64(time.Now().Unix())
+		now := n.clock.Now()
 
 		if n.targetGasLimit == 0 {
 			// no preset, use suggested
@@ -46,12 +56,13 @@ func (n *Node) packerLoop(ctx context.Context) {
 			n.packer.SetTargetGasLimit(suggested)
 		}
 
-		flow, err := n.packer.Schedule(n.repo.BestBlock().Header(), now)
+		flow, err := n.packer.Schedule(n.repo.BestBlockSummary().Header, now)
 		if err != nil {
 			if authorized {
 				authorized = false
 				log.Warn("unable to pack block", "err", err)
 			}
+			n.setPackerState(nil, authorized)
 			select {
 			case <-ctx.Done():
 				return
@@ -64,22 +75,34 @@ func (n *Node) packerLoop(ctx context.Context) {
 			authorized = true
 			log.Info("prepared to pack block")
 		}
-		log.Debug("scheduled to pack block", "after", time.Duration(flow.When()-now)*time.Second)
+		n.setPackerState(flow, authorized)
+		flowLog := newFlowLogger(flow)
+		flowLog.Debug("scheduled to pack block", "after", time.Duration(flow.When()-now)*time.Second)
 
 		for {
-			if uint64(time.Now().Unix())+thor.BlockInterval/2 > flow.When() {
+			if n.clock.Now()+thor.BlockInterval/2 > flow.When() {
 				// time to pack block
 				// blockInterval/2 early to allow more time for processing txs
-				if err := n.pack(flow); err != nil {
-					log.Error("failed to pack block", "err", err)
+				_, stats, err := n.pack(ctx, flow)
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					flowLog.Error("failed to pack block", "err", err)
 				}
+				flowLog.Debug("txs adopted",
+					"adopted", stats.Adopted,
+					"gasRejected", stats.GasRejected,
+					"removed", stats.Removed,
+					"elapsed", common.PrettyDuration(stats.Elapsed))
+				n.setPackerState(nil, authorized)
 				break
 			}
 			select {
 			case <-ctx.Done():
 				return
-			case <-time.After(time.Second):
-				best := n.repo.BestBlock().Header()
+			case <-n.clock.After(time.Second):
+				best := n.repo.BestBlockSummary().Header
 				/*  re-schedule regarding the following two conditions:
 				1. parent block needs to update and the new best is not proposed by the same one
 				2. best block is better than the block to be proposed
@@ -90,7 +113,7 @@ func (n *Node) packerLoop(ctx context.Context) {
 
 				if (best.Number() == flow.ParentHeader().Number() && s1 != s2) ||
 					best.TotalScore() > flow.TotalScore() {
-					log.Debug("re-schedule packer due to new best block")
+					flowLog.Debug("re-schedule packer due to new best block")
 					goto RE_SCHEDULE
 				}
 			}
@@ -99,40 +122,180 @@ func (n *Node) packerLoop(ctx context.Context) {
 	}
 }
 
-func (n *Node) pack(flow *packer.Flow) error {
-	txs := n.txPool.Executables()
-	var txsToRemove []*tx.Transaction
-	defer func() {
-		for _, tx := range txsToRemove {
-			n.txPool.Remove(tx.Hash(), tx.ID())
+// newFlowLogger derives a logger bound with the context of the scheduled flow,
+// so that logs of one packing round can be told apart.
+func newFlowLogger(flow *packer.Flow) log15.Logger {
+	return log.New(
+		"number", flow.ParentHeader().Number()+1,
+		"parent", shortID(flow.ParentHeader().ID()),
+		"when", flow.When())
+}
+
+// PackerStatus describes the current state of the packer loop.
+type PackerStatus struct {
+	Authorized bool         // whether the node master is authorized to pack blocks
+	Scheduled  bool         // whether a packing flow is scheduled
+	When       uint64       // the scheduled time to pack the block
+	ParentID   thor.Bytes32 // id of the parent block the flow is scheduled on
+}
+
+// PackerStatus returns a snapshot of the packer loop state.
+// The zero value is returned when no flow is scheduled.
+func (n *Node) PackerStatus() PackerStatus {
+	n.packerLock.Lock()
+	defer n.packerLock.Unlock()
+
+	if n.flow == nil {
+		return PackerStatus{}
+	}
+	return PackerStatus{
+		Authorized: n.authorized,
+		Scheduled:  true,
+		When:       n.flow.When(),
+		ParentID:   n.flow.ParentHeader().ID(),
+	}
+}
+
+func (n *Node) setPackerState(flow *packer.Flow, authorized bool) {
+	n.packerLock.Lock()
+	defer n.packerLock.Unlock()
+
+	n.flow = flow
+	n.authorized = authorized
+}
+
+// PackNow schedules a packing flow upon the current best block and packs it at once,
+// regardless of the scheduled time. It's intended for tests to produce blocks on demand.
+func (n *Node) PackNow(ctx context.Context) (*block.Block, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	flow, err := n.packer.Schedule(n.repo.BestBlockSummary().Header, n.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	blk, _, err := n.pack(ctx, flow)
+	return blk, err
+}
+
+// AdoptStats summarizes the tx adoption of a packing round.
+type AdoptStats struct {
+	Adopted     int           // txs adopted into the block
+	GasRejected int           // txs left out since the block gas limit reached
+	Removed     int           // invalid txs removed from the pool
+	Elapsed     time.Duration // time spent on adoption
+}
+
+// RoundTiming describes time spent on each phase of a packed round.
+type RoundTiming struct {
+	Adopt  time.Duration // adopting txs
+	Pack   time.Duration // finalizing and signing the block
+	Commit time.Duration // committing state and block
+}
+
+type timingSub struct {
+	ch chan<- *RoundTiming
+}
+
+// SubscribeRoundTiming subscribes timing of each round that packed a block.
+// The delivery never blocks the packer, so timings are dropped if ch is not ready.
+func (n *Node) SubscribeRoundTiming(ch chan<- *RoundTiming) event.Subscription {
+	sub := &timingSub{ch}
+
+	n.timingLock.Lock()
+	n.timingSubs[sub] = struct{}{}
+	n.timingLock.Unlock()
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		n.timingLock.Lock()
+		delete(n.timingSubs, sub)
+		n.timingLock.Unlock()
+		return nil
+	})
+}
+
+func (n *Node) sendRoundTiming(timing *RoundTiming) {
+	n.timingLock.Lock()
+	defer n.timingLock.Unlock()
+
+	for sub := range n.timingSubs {
+		select {
+		case sub.ch <- timing:
+		default:
 		}
-	}()
+	}
+}
 
+// adoptTxs tries to adopt txs into the flow in order, and returns txs that should be removed from the pool.
+// It stops early with the context error if ctx is done.
+func adoptTxs(ctx context.Context, flow *packer.Flow, txs tx.Transactions) (stats AdoptStats, txsToRemove []*tx.Transaction, err error) {
 	startTime := mclock.Now()
-	for _, tx := range txs {
+	defer func() {
+		stats.Removed = len(txsToRemove)
+		stats.Elapsed = time.Duration(mclock.Now() - startTime)
+	}()
+
+	for i, tx := range txs {
+		select {
+		case <-ctx.Done():
+			return stats, txsToRemove, ctx.Err()
+		default:
+		}
 		if err := flow.Adopt(tx); err != nil {
 			if packer.IsGasLimitReached(err) {
+				stats.GasRejected = len(txs) - i
 				break
 			}
 			if packer.IsTxNotAdoptableNow(err) {
 				continue
 			}
 			txsToRemove = append(txsToRemove, tx)
+			continue
+		}
+		stats.Adopted++
+	}
+	return
+}
+
+func (n *Node) pack(ctx context.Context, flow *packer.Flow) (*block.Block, AdoptStats, error) {
+	txs := n.txPool.Executables()
+	startTime := mclock.Now()
+	stats, txsToRemove, err := adoptTxs(ctx, flow, txs)
+	defer func() {
+		for _, tx := range txsToRemove {
+			n.txPool.Remove(tx.Hash(), tx.ID())
 		}
+	}()
+	if err != nil {
+		return nil, stats, err
+	}
+	// don't pack if cancelled while adopting the last tx
+	if err := ctx.Err(); err != nil {
+		return nil, stats, err
 	}
 
 	newBlock, stage, receipts, err := flow.Pack(n.master.PrivateKey)
 	if err != nil {
-		return err
+		return nil, stats, err
 	}
 	execElapsed := mclock.Now() - startTime
 
 	prevTrunk, curTrunk, err := n.commitBlock(stage, newBlock, receipts)
 	if err != nil {
-		return errors.WithMessage(err, "commit block")
+		return nil, stats, errors.WithMessage(err, "commit block")
 	}
 	commitElapsed := mclock.Now() - startTime - execElapsed
 
+	n.sendRoundTiming(&RoundTiming{
+		Adopt:  stats.Elapsed,
+		Pack:   time.Duration(execElapsed) - stats.Elapsed,
+		Commit: time.Duration(commitElapsed),
+	})
+
 	n.processFork(prevTrunk, curTrunk)
 
 	if prevTrunk.HeadID() != curTrunk.HeadID() {
@@ -148,5 +311,5 @@ func (n *Node) pack(flow *packer.Flow) error {
 	if v, updated := n.bandwidth.Update(newBlock.Header(), time.Duration(execElapsed+commitElapsed)); updated {
 		log.Debug("bandwidth updated", "gps", v)
 	}
-	return nil
+	return newBlock, stats, nil
 }
@@ -46,11 +46,9 @@ func New(repo *chain.Repository, stater *state.Stater, forkConfig thor.ForkConfi
 func (c *Consensus) Process(blk *block.Block, nowTimestamp uint64) (*state.Stage, tx.Receipts, error) {
 	header := blk.Header()
 
-	if _, err := c.repo.GetBlockSummary(header.ID()); err != nil {
-		if !c.repo.IsNotFound(err) {
-			return nil, nil, err
-		}
-	} else {
+	if known, err := c.repo.HasBlock(header.ID()); err != nil {
+		return nil, nil, err
+	} else if known {
 		return nil, nil, errKnownBlock
 	}
 
@@ -77,6 +77,41 @@ func (f *Flow) findTx(txID thor.Bytes32) (found bool, reverted bool, err error)
 // If the tx is valid and can be executed on current state (regardless of VM error),
 // it will be adopted by the new block.
 func (f *Flow) Adopt(tx *tx.Transaction) error {
+	if err := f.checkAdoptable(tx); err != nil {
+		return err
+	}
+
+	checkpoint := f.runtime.State().NewCheckpoint()
+	receipt, err := f.runtime.ExecuteTransaction(tx)
+	if err != nil {
+		// skip and revert state
+		f.runtime.State().RevertTo(checkpoint)
+		return badTxError{err.Error()}
+	}
+	f.processedTxs[tx.ID()] = receipt.Reverted
+	f.gasUsed += receipt.GasUsed
+	f.receipts = append(f.receipts, receipt)
+	f.txs = append(f.txs, tx)
+	return nil
+}
+
+// CanAdopt checks if the given transaction can be adopted by the new block, without adopting it.
+// It returns the same errors as Adopt does, and the state is always reverted after execution.
+func (f *Flow) CanAdopt(tx *tx.Transaction) error {
+	if err := f.checkAdoptable(tx); err != nil {
+		return err
+	}
+
+	checkpoint := f.runtime.State().NewCheckpoint()
+	defer f.runtime.State().RevertTo(checkpoint)
+
+	if _, err := f.runtime.ExecuteTransaction(tx); err != nil {
+		return badTxError{err.Error()}
+	}
+	return nil
+}
+
+func (f *Flow) checkAdoptable(tx *tx.Transaction) error {
 	origin, _ := tx.Origin()
 	if f.runtime.Context().Number >= f.packer.forkConfig.BLOCKLIST && thor.IsOriginBlocked(origin) {
 		return badTxError{"tx origin blocked"}
@@ -122,18 +157,6 @@ func (f *Flow) Adopt(tx *tx.Transaction) error {
 			return errTxNotAdoptableForever
 		}
 	}
-
-	checkpoint := f.runtime.State().NewCheckpoint()
-	receipt, err := f.runtime.ExecuteTransaction(tx)
-	if err != nil {
-		// skip and revert state
-		f.runtime.State().RevertTo(checkpoint)
-		return badTxError{err.Error()}
-	}
-	f.processedTxs[tx.ID()] = receipt.Reverted
-	f.gasUsed += receipt.GasUsed
-	f.receipts = append(f.receipts, receipt)
-	f.txs = append(f.txs, tx)
 	return nil
 }
 
@@ -6,8 +6,10 @@
 package packer
 
 import (
+	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/authority"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/poa"
 	"github.com/vechain/thor/runtime"
@@ -67,11 +69,7 @@ func (p *Packer) Schedule(parent *block.Header, nowTimestamp uint64) (flow *Flow
 	}
 
 	authority := builtin.Authority.Native(state)
-	endorsement, err := builtin.Params.Native(state).Get(thor.KeyProposerEndorsement)
-	if err != nil {
-		return nil, err
-	}
-	candidates, err := authority.Candidates(endorsement, thor.MaxBlockProposers)
+	candidates, err := loadCandidates(state)
 	if err != nil {
 		return nil, err
 	}
@@ -125,6 +123,46 @@ func (p *Packer) Schedule(parent *block.Header, nowTimestamp uint64) (flow *Flow
 	return newFlow(p, parent, rt, features), nil
 }
 
+// LeaderOf returns the proposer scheduled to pack the block upon the parent in the given round,
+// along with the block time. Round r is the r-th time slot after the parent block, starting from 1.
+// Only active proposers are taken into account.
+func (p *Packer) LeaderOf(parent *block.Header, round uint32) (thor.Address, uint64, error) {
+	if round == 0 {
+		return thor.Address{}, 0, errors.New("round should be greater than 0")
+	}
+
+	candidates, err := loadCandidates(p.stater.NewState(parent.StateRoot()))
+	if err != nil {
+		return thor.Address{}, 0, err
+	}
+
+	var (
+		proposers = make([]poa.Proposer, 0, len(candidates))
+		active    *thor.Address
+	)
+	for _, c := range candidates {
+		if c.Active && active == nil {
+			active = &c.NodeMaster
+		}
+		proposers = append(proposers, poa.Proposer{
+			Address: c.NodeMaster,
+			Active:  c.Active,
+		})
+	}
+	if active == nil {
+		return thor.Address{}, 0, errors.New("no active proposer")
+	}
+
+	// schedule on behalf of an active proposer, so that only active ones are in turn
+	sched, err := poa.NewScheduler(*active, proposers, parent.Number(), parent.Timestamp())
+	if err != nil {
+		return thor.Address{}, 0, err
+	}
+
+	blockTime := parent.Timestamp() + uint64(round)*thor.BlockInterval
+	return sched.WhoseTurn(blockTime).Address, blockTime, nil
+}
+
 // Mock create a packing flow upon given parent, but with a designated timestamp.
 // It will skip the PoA verification and scheduling, and the block produced by
 // the returned flow is not in consensus.
@@ -169,6 +207,14 @@ func (p *Packer) Mock(parent *block.Header, targetTime uint64, gasLimit uint64)
 	return newFlow(p, parent, rt, features), nil
 }
 
+func loadCandidates(state *state.State) ([]*authority.Candidate, error) {
+	endorsement, err := builtin.Params.Native(state).Get(thor.KeyProposerEndorsement)
+	if err != nil {
+		return nil, err
+	}
+	return builtin.Authority.Native(state).Candidates(endorsement, thor.MaxBlockProposers)
+}
+
 func (p *Packer) gasLimit(parentGasLimit uint64) uint64 {
 	if p.targetGasLimit != 0 {
 		return block.GasLimit(p.targetGasLimit).Qualify(parentGasLimit)
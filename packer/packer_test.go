@@ -224,3 +224,87 @@ func TestBlocklist(t *testing.T) {
 		t.Fatal("adopt tx from non-blocked origin should not return error")
 	}
 }
+
+func TestCanAdopt(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	a1 := genesis.DevAccounts()[1]
+
+	p := packer.New(repo, stater, a0.Address, &a0.Address, thor.NoFork)
+	flow, err := p.Schedule(repo.BestBlock().Header(), uint64(time.Now().Unix()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newTx := func(nonce uint64, gas uint64, blockRef uint32) *tx.Transaction {
+		trx := new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Clause(tx.NewClause(&a1.Address).WithValue(big.NewInt(1))).
+			BlockRef(tx.NewBlockRef(blockRef)).
+			Gas(gas).Nonce(nonce).Expiration(math.MaxUint32).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), a0.PrivateKey)
+		return trx.WithSignature(sig)
+	}
+
+	tx0 := newTx(0, 21000, 0)
+	assert.Nil(t, flow.CanAdopt(tx0))
+	assert.True(t, packer.IsTxNotAdoptableNow(flow.CanAdopt(newTx(1, 21000, 100))))
+	assert.True(t, packer.IsBadTx(flow.CanAdopt(newTx(2, 1, 0))))
+
+	// nothing adopted by CanAdopt
+	blk, stage, _, err := flow.Pack(a0.PrivateKey)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(blk.Transactions()))
+	assert.Equal(t, uint64(0), blk.Header().GasUsed())
+	assert.Equal(t, b0.Header().StateRoot(), stage.Hash())
+
+	// tx checked is still adoptable
+	assert.Nil(t, flow.Adopt(tx0))
+	assert.True(t, packer.IsKnownTx(flow.CanAdopt(tx0)))
+}
+
+func TestLeaderOf(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	launchTime := uint64(1526400000)
+
+	b0, _, _, err := new(genesis.Builder).
+		GasLimit(thor.InitialGasLimit).
+		Timestamp(launchTime).
+		State(func(state *state.State) error {
+			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			for _, acc := range genesis.DevAccounts() {
+				builtin.Authority.Native(state).Add(acc.Address, acc.Address, thor.Bytes32{})
+			}
+			return nil
+		}).
+		Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ := chain.NewRepository(db, b0)
+
+	authorized := make(map[thor.Address]bool)
+	for _, acc := range genesis.DevAccounts() {
+		authorized[acc.Address] = true
+	}
+
+	_, _, err = packer.New(repo, stater, thor.Address{}, nil, thor.NoFork).LeaderOf(b0.Header(), 0)
+	assert.NotNil(t, err)
+
+	for round := uint32(1); round <= 10; round++ {
+		leader, when, err := packer.New(repo, stater, thor.Address{}, nil, thor.NoFork).LeaderOf(b0.Header(), round)
+		assert.Nil(t, err)
+		assert.Equal(t, launchTime+uint64(round)*thor.BlockInterval, when)
+
+		// the leader should be scheduled at exactly the same time
+		assert.True(t, authorized[leader])
+		flow, err := packer.New(repo, stater, leader, nil, thor.NoFork).Schedule(b0.Header(), when)
+		assert.Nil(t, err)
+		assert.Equal(t, when, flow.When())
+	}
+}
@@ -6,6 +6,7 @@
 package txpool
 
 import (
+	"bytes"
 	"math/big"
 	"sort"
 	"time"
@@ -95,6 +96,14 @@ func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock
 func sortTxObjsByOverallGasPriceDesc(txObjs []*txObject) {
 	sort.Slice(txObjs, func(i, j int) bool {
 		gp1, gp2 := txObjs[i].overallGasPrice, txObjs[j].overallGasPrice
-		return gp1.Cmp(gp2) >= 0
+		if c := gp1.Cmp(gp2); c != 0 {
+			return c > 0
+		}
+		// break ties deterministically, so that the order doesn't depend on insertion
+		if n1, n2 := txObjs[i].Nonce(), txObjs[j].Nonce(); n1 != n2 {
+			return n1 < n2
+		}
+		id1, id2 := txObjs[i].ID(), txObjs[j].ID()
+		return bytes.Compare(id1[:], id2[:]) < 0
 	})
 }
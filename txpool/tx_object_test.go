@@ -63,6 +63,27 @@ func TestSort(t *testing.T) {
 	assert.Equal(t, big.NewInt(10), objs[2].overallGasPrice)
 }
 
+func TestSortTieBreak(t *testing.T) {
+	var objs []*txObject
+	for i := 0; i < 10; i++ {
+		tx := newTx(0, nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[i%2])
+		objs = append(objs, &txObject{Transaction: tx, overallGasPrice: big.NewInt(int64(i % 3))})
+	}
+
+	sorted := append([]*txObject(nil), objs...)
+	sortTxObjsByOverallGasPriceDesc(sorted)
+
+	for i := 0; i < 5; i++ {
+		shuffled := append([]*txObject(nil), objs...)
+		rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		sortTxObjsByOverallGasPriceDesc(shuffled)
+		assert.Equal(t, sorted, shuffled)
+	}
+	for i := 1; i < len(sorted); i++ {
+		assert.True(t, sorted[i-1].overallGasPrice.Cmp(sorted[i].overallGasPrice) >= 0)
+	}
+}
+
 func TestResolve(t *testing.T) {
 	acc := genesis.DevAccounts()[0]
 	tx := newTx(0, nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), acc)